@@ -0,0 +1,7 @@
+data "jamfpro_mobile_device_extension_attribute" "asset_tier" {
+  name = "Asset Tier"
+}
+
+output "jamfpro_mobile_device_extension_attribute_asset_tier_id" {
+  value = data.jamfpro_mobile_device_extension_attribute.asset_tier.id
+}
@@ -0,0 +1,7 @@
+data "jamfpro_mobile_device_prestage_enrollment" "classroom_ipads" {
+  display_name = "Classroom iPads"
+}
+
+output "jamfpro_mobile_device_prestage_enrollment_classroom_ipads_id" {
+  value = data.jamfpro_mobile_device_prestage_enrollment.classroom_ipads.id
+}
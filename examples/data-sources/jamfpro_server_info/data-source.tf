@@ -0,0 +1,10 @@
+data "jamfpro_server_info" "current" {}
+
+output "jamfpro_version" {
+  value = data.jamfpro_server_info.current.version
+}
+
+# Only manage the Casper Admin privileges of an account on releases which still have Casper Admin.
+locals {
+  casper_admin_supported = data.jamfpro_server_info.current.major_version < 11 || (data.jamfpro_server_info.current.major_version == 11 && data.jamfpro_server_info.current.minor_version < 6)
+}
@@ -0,0 +1,7 @@
+data "jamfpro_smart_mobile_device_group" "ipads_ios17" {
+  name = "iPads on iPadOS 17"
+}
+
+output "jamfpro_smart_mobile_device_group_ipads_ios17_id" {
+  value = data.jamfpro_smart_mobile_device_group.ipads_ios17.id
+}
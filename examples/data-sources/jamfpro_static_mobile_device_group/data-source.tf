@@ -0,0 +1,7 @@
+data "jamfpro_static_mobile_device_group" "loaner_ipads" {
+  id = jamfpro_static_mobile_device_group.loaner_ipads.id
+}
+
+output "jamfpro_static_mobile_device_group_loaner_ipads_name" {
+  value = data.jamfpro_static_mobile_device_group.loaner_ipads.name
+}
@@ -0,0 +1,12 @@
+resource "time_rotating" "jamfpro_api_integration_001" {
+  rotation_days = 90
+}
+
+resource "jamfpro_api_integration_client_credentials" "jamfpro_api_integration_001" {
+  api_integration_id = jamfpro_api_integration.jamfpro_api_integration_001.id
+  rotate_after       = time_rotating.jamfpro_api_integration_001.rotation_rfc3339
+
+  rotation_trigger = {
+    owner = "ci-robots"
+  }
+}
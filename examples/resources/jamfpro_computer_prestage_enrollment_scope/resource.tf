@@ -0,0 +1,6 @@
+resource "jamfpro_computer_prestage_enrollment_scope" "staff_macs" {
+  prestage_id = jamfpro_computer_prestage_enrollment.staff.id
+
+  # Serial numbers exported from the asset management system.
+  serial_numbers = [for asset in var.staff_mac_assets : asset.serial_number]
+}
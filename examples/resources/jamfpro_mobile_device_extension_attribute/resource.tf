@@ -0,0 +1,40 @@
+resource "jamfpro_mobile_device_extension_attribute" "asset_tier" {
+  name              = "tf-example-mdexa-asset-tier"
+  description       = "The support tier of the device, collected from a pop-up menu."
+  input_type        = "Pop-up Menu"
+  input_popup       = ["Standard", "Executive", "Kiosk"]
+  inventory_display = "General"
+}
+
+# //-------------------------------------------------------------------//
+
+resource "jamfpro_mobile_device_extension_attribute" "asset_owner" {
+  name              = "tf-example-mdexa-asset-owner"
+  description       = "An attribute collected from a text field."
+  input_type        = "Text Field"
+  inventory_display = "User and Location"
+}
+
+# //-------------------------------------------------------------------//
+
+resource "jamfpro_mobile_device_extension_attribute" "cost_center" {
+  name                    = "tf-example-mdexa-cost-center"
+  description             = "An attribute mapped from the directory service."
+  input_type              = "LDAP Attribute Mapping"
+  input_directory_mapping = "extensionAttribute1"
+  inventory_display       = "User and Location"
+}
+
+# //-------------------------------------------------------------------//
+
+# Referencing the attribute by name makes the smart group depend on it, so it is created first.
+resource "jamfpro_smart_mobile_device_group" "kiosk_ipads" {
+  name = "tf-example-smart-mobile-group-kiosk-ipads"
+
+  criteria {
+    name        = jamfpro_mobile_device_extension_attribute.asset_tier.name
+    search_type = "is"
+    value       = "Kiosk"
+    priority    = 0
+  }
+}
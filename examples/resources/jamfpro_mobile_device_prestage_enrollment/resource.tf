@@ -0,0 +1,49 @@
+resource "jamfpro_mobile_device_prestage_enrollment" "classroom_ipads" {
+  display_name                          = "Classroom iPads"
+  device_enrollment_program_instance_id = "1"
+  support_phone_number                  = "555-0100"
+  support_email_address                 = "help@example.com"
+  department                            = "Education"
+  supervised                            = true
+  allow_pairing                         = false
+  mdm_removable                         = false
+  prevent_activation_lock               = true
+
+  skip_setup_items {
+    location        = true
+    apple_id        = true
+    siri            = true
+    screen_time     = true
+    software_update = true
+    payment         = true
+    tos             = true
+  }
+
+  location_information {
+    room          = "Library"
+    department_id = jamfpro_department.education.id
+    building_id   = jamfpro_building.main_campus.id
+  }
+
+  # Name devices iPad-<serial number>, renaming them if users change it.
+  names {
+    assign_names_using = "Serial Numbers"
+    device_name_prefix = "iPad-"
+    manage_names       = true
+  }
+
+  # Shared iPad settings
+  multi_user                        = true
+  maximum_shared_accounts           = 10
+  use_storage_quota_size            = true
+  storage_quota_size_megabytes      = 4096
+  enforce_user_session_timeout      = true
+  user_session_timeout              = 900
+  enforce_temporary_session_timeout = true
+  temporary_session_timeout         = 300
+
+  assigned_device_serial_numbers = [
+    "DMPXK2AAAAAA",
+    "DMPXK2BBBBBB",
+  ]
+}
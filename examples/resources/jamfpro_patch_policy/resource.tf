@@ -0,0 +1,51 @@
+# Installs the latest Chrome automatically on every computer except the pilot group.
+resource "jamfpro_patch_policy" "google_chrome_automatic" {
+  name                            = "Google Chrome - Automatic"
+  enabled                         = true
+  software_title_configuration_id = jamfpro_patch_software_title_configuration.google_chrome.id
+  target_version                  = "125.0.6422.60"
+  distribution_method             = "prompt"
+
+  kill_app {
+    kill_app_name      = "Google Chrome.app"
+    kill_app_bundle_id = "com.google.Chrome"
+  }
+
+  scope {
+    all_computers = true
+
+    exclusions {
+      computer_group_ids = [jamfpro_static_computer_group.chrome_pilot.id]
+    }
+  }
+
+  grace_period {
+    duration                    = 30
+    notification_center_subject = "Important"
+    message                     = "Google Chrome will quit in 30 minutes to install an update. Save your work."
+  }
+}
+
+# Offers the same version in Self Service to the pilot group, installing it after 3 days.
+resource "jamfpro_patch_policy" "google_chrome_self_service" {
+  name                            = "Google Chrome - Self Service"
+  enabled                         = true
+  software_title_configuration_id = jamfpro_patch_software_title_configuration.google_chrome.id
+  target_version                  = "125.0.6422.60"
+  distribution_method             = "selfservice"
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [jamfpro_static_computer_group.chrome_pilot.id]
+  }
+
+  self_service {
+    install_button_text  = "Update"
+    description          = "Updates Google Chrome to the latest version."
+    notification_enabled = true
+    notification_subject = "Google Chrome update available"
+    notification_message = "Install the update from Self Service."
+    deadline_enabled     = true
+    deadline_period      = 3
+  }
+}
@@ -0,0 +1,24 @@
+resource "jamfpro_patch_software_title_configuration" "google_chrome" {
+  display_name      = "Google Chrome"
+  software_title_id = "3" # The ID of Google Chrome among the available titles of the Jamf patch source
+  category_id       = jamfpro_category.browsers.id
+
+  ui_notifications    = true
+  email_notifications = false
+
+  # Chrome reports its version through an extension attribute which must be accepted.
+  extension_attribute {
+    ea_id    = "google-chrome-ea"
+    accepted = true
+  }
+
+  package {
+    package_id = jamfpro_package.google_chrome_124.id
+    version    = "124.0.6367.60"
+  }
+
+  package {
+    package_id = jamfpro_package.google_chrome_125.id
+    version    = "125.0.6422.60"
+  }
+}
@@ -0,0 +1,35 @@
+resource "jamfpro_smart_mobile_device_group" "ipads_ios17" {
+  name = "iPads on iPadOS 17"
+
+  # Optional: Specify site details
+  site_id = 5
+
+  # Optional: Define criteria for Smart groups
+  criteria {
+    name        = "Model"
+    priority    = 0 # 0 is the highest priority, 1 is the next highest, etc.
+    search_type = "like"
+    value       = "iPad"
+  }
+
+  criteria {
+    name        = "OS Version"
+    priority    = 1
+    and_or      = "and" # or "or", defaults to "and" if not provided
+    search_type = "greater than or equal"
+    value       = "17.0"
+  }
+}
+
+resource "jamfpro_mobile_device_configuration_profile_plist" "ipad_wifi" {
+  name               = "iPad Wi-Fi"
+  deployment_method  = "Install Automatically"
+  level              = "Device Level"
+  redeploy_on_update = "Newly Assigned"
+  payloads           = file("${path.module}/profiles/ipad-wifi.mobileconfig")
+
+  scope {
+    all_mobile_devices      = false
+    mobile_device_group_ids = [jamfpro_smart_mobile_device_group.ipads_ios17.id]
+  }
+}
@@ -0,0 +1,9 @@
+resource "jamfpro_static_mobile_device_group" "loaner_ipads" {
+  name = "Loaner iPads"
+
+  # Optional Block
+  site_id = 1
+
+  # Optional: Specify mobile devices for static groups
+  assigned_mobile_device_ids = [1, 2, 3]
+}
@@ -0,0 +1,21 @@
+provider "jamfpro" {
+  alias                 = "tenant_emea"
+  jamfpro_instance_fqdn = "https://emea.jamfcloud.com"
+  auth_method           = "oauth2"
+  client_id             = var.emea_client_id
+  client_secret         = var.emea_client_secret
+}
+
+// Revokes the cached token of the provider when the configuration is destroyed.
+resource "jamfpro_token_invalidation" "tenant_emea" {
+  provider = jamfpro.tenant_emea
+}
+
+resource "jamfpro_category" "tenant_emea_utilities" {
+  provider = jamfpro.tenant_emea
+  name     = "Utilities"
+  priority = 9
+
+  // Destroyed before the token is invalidated.
+  depends_on = [jamfpro_token_invalidation.tenant_emea]
+}
@@ -0,0 +1,116 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// exportFile writes logs to a file as JSON lines, rotating it when it grows past maxSize. Rotated files are named
+// after the file with the suffixes .1, .2 and so on, .1 being the newest.
+type exportFile struct {
+	mu         sync.Mutex
+	path       string
+	file       *os.File
+	size       int64
+	maxSize    int64
+	maxBackups int
+}
+
+// openExportFile opens the export file at path for appending, creating it if needed.
+func openExportFile(path string, maxSize int64, maxBackups int) (*exportFile, error) {
+	e := &exportFile{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := e.open(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// open opens the file and records its size.
+func (e *exportFile) open() error {
+	file, err := os.OpenFile(e.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open log export file: %v", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to open log export file: %v", err)
+	}
+
+	e.file = file
+	e.size = info.Size()
+	return nil
+}
+
+// write appends a log entry in the JSON format of Terraform logs. Failures are ignored, as there is nowhere left
+// to report them.
+func (e *exportFile) write(at time.Time, level hclog.Level, subsystem, msg string, fields map[string]interface{}) {
+	entry := make(map[string]interface{}, len(fields)+4)
+	for key, value := range fields {
+		entry[key] = value
+	}
+	entry["@timestamp"] = at.Format(time.RFC3339Nano)
+	entry["@level"] = level.String()
+	entry["@module"] = "jamfpro." + subsystem
+	entry["@message"] = msg
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.file == nil {
+		return
+	}
+	if e.maxSize > 0 && e.size > 0 && e.size+int64(len(line)) > e.maxSize {
+		if err := e.rotate(); err != nil {
+			return
+		}
+	}
+
+	n, _ := e.file.Write(line)
+	e.size += int64(n)
+}
+
+// rotate shifts the rotated files along, dropping the oldest, and starts a new file.
+func (e *exportFile) rotate() error {
+	e.file.Close()
+	e.file = nil
+
+	if e.maxBackups > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", e.path, e.maxBackups))
+		for i := e.maxBackups - 1; i >= 1; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", e.path, i), fmt.Sprintf("%s.%d", e.path, i+1))
+		}
+		if err := os.Rename(e.path, e.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Truncate(e.path, 0); err != nil {
+		return err
+	}
+
+	return e.open()
+}
+
+// Close closes the file.
+func (e *exportFile) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.file == nil {
+		return nil
+	}
+	err := e.file.Close()
+	e.file = nil
+	return err
+}
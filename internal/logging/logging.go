@@ -0,0 +1,270 @@
+// Package logging routes the logs of the provider, its resources and the Jamf Pro SDK through terraform-plugin-log.
+//
+// Logs are written to subsystems of the provider logger, so their levels can be set one by one through
+// TF_LOG_PROVIDER_JAMFPRO_HTTP, TF_LOG_PROVIDER_JAMFPRO_PLIST and TF_LOG_PROVIDER_JAMFPRO_CRUD, falling back to
+// TF_LOG_PROVIDER. Logs are written with the context they are given, so they carry the fields Terraform adds to
+// each request and the settings of the provider configuration the request is for. Code which has no context to log
+// with passes context.Background() and falls back to the provider configured last.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SubsystemHTTP holds the logs of the HTTP client, the Jamf Pro SDK and the provider transport.
+	SubsystemHTTP = "http"
+	// SubsystemPlist holds the logs of plist processing and configuration profile diff suppression.
+	SubsystemPlist = "plist"
+	// SubsystemCRUD holds the logs of resource and data source operations.
+	SubsystemCRUD = "crud"
+
+	// envVarLevelPrefix is the prefix of the environment variables setting the level of a subsystem.
+	envVarLevelPrefix = "TF_LOG_PROVIDER_JAMFPRO"
+	// maskedValue replaces sensitive values.
+	maskedValue = "***"
+)
+
+// subsystems are the subsystems created by Configure.
+var subsystems = []string{SubsystemHTTP, SubsystemPlist, SubsystemCRUD}
+
+// sensitiveFieldKeys are field keys whose values are masked, compared without regard to case.
+var sensitiveFieldKeys = []string{
+	"access_token",
+	"authorization",
+	"basic_auth_password",
+	"client_secret",
+	"cookie",
+	"password",
+	"refresh_token",
+	"set-cookie",
+	"token",
+}
+
+// sensitiveMessagePatterns match secrets within messages. The first group of each is kept.
+var sensitiveMessagePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9._~+/=-]+`),
+	regexp.MustCompile(`(?i)(basic\s+)[A-Za-z0-9+/=]{8,}`),
+	regexp.MustCompile(`(?i)("?(?:access_token|client_secret|password|token)"?\s*[:=]\s*"?)[^"&,\s}]+`),
+}
+
+// Config defines how logs are processed.
+type Config struct {
+	// HideSensitiveData masks credentials and tokens in messages and fields.
+	HideSensitiveData bool
+	// ExportPath is the path of a file logs are also written to as JSON lines, none if empty.
+	ExportPath string
+	// ExportMaxSizeMB is the size in megabytes at which the export file is rotated.
+	ExportMaxSizeMB int
+	// ExportMaxBackups is the number of rotated export files kept.
+	ExportMaxBackups int
+}
+
+// settings are the settings of a provider configuration, held by the contexts it logs with.
+type settings struct {
+	hide   bool
+	export *exportFile
+}
+
+// settingsKey is the context key of settings.
+type settingsKey struct{}
+
+var (
+	// fallback is the context returned by the last call to Configure, used to log with contexts which hold no
+	// settings.
+	fallback   = context.WithValue(context.Background(), settingsKey{}, &settings{hide: true})
+	fallbackMu sync.RWMutex
+
+	// exports are the export files opened by Configure, by path. Provider configurations exporting to the same
+	// path share a file, which stays open as long as the provider runs.
+	exports   = map[string]*exportFile{}
+	exportsMu sync.Mutex
+)
+
+// Configure creates the subsystems on the provider context passed to ConfigureContextFunc and attaches the settings
+// of config to it. It returns the context, which is also used from then on to log with contexts holding no settings.
+func Configure(ctx context.Context, config Config) (context.Context, error) {
+	var export *exportFile
+	if config.ExportPath != "" {
+		var err error
+		export, err = exportFileAt(config.ExportPath, int64(config.ExportMaxSizeMB)*1024*1024, config.ExportMaxBackups)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	ctx = withSubsystems(ctx)
+	ctx = context.WithValue(ctx, settingsKey{}, &settings{hide: config.HideSensitiveData, export: export})
+
+	fallbackMu.Lock()
+	defer fallbackMu.Unlock()
+	fallback = ctx
+
+	return ctx, nil
+}
+
+// WithSettings returns ctx with the subsystems and settings of configured, a context returned by Configure. Logs
+// written with it carry the fields of ctx, such as the ID Terraform gives each request, and follow the settings of
+// the provider configuration of configured. ctx is returned as it is if configured holds no settings.
+func WithSettings(ctx, configured context.Context) context.Context {
+	s, ok := configured.Value(settingsKey{}).(*settings)
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(withSubsystems(ctx), settingsKey{}, s)
+}
+
+// withSubsystems returns ctx with the subsystems created.
+func withSubsystems(ctx context.Context) context.Context {
+	for _, subsystem := range subsystems {
+		ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv(envVarLevelPrefix, strings.ToUpper(subsystem)))
+	}
+	return ctx
+}
+
+// exportFileAt returns the export file opened at path, opening it if needed. The size and backup settings of the
+// first provider configuration exporting to path apply.
+func exportFileAt(path string, maxSize int64, maxBackups int) (*exportFile, error) {
+	exportsMu.Lock()
+	defer exportsMu.Unlock()
+
+	if export, ok := exports[path]; ok {
+		return export, nil
+	}
+
+	export, err := openExportFile(path, maxSize, maxBackups)
+	if err != nil {
+		return nil, err
+	}
+	exports[path] = export
+	return export, nil
+}
+
+// Tracef logs a message at trace level to a subsystem.
+func Tracef(ctx context.Context, subsystem, format string, args ...interface{}) {
+	Log(ctx, hclog.Trace, subsystem, fmt.Sprintf(format, args...), nil)
+}
+
+// Debugf logs a message at debug level to a subsystem.
+func Debugf(ctx context.Context, subsystem, format string, args ...interface{}) {
+	Log(ctx, hclog.Debug, subsystem, fmt.Sprintf(format, args...), nil)
+}
+
+// Infof logs a message at info level to a subsystem.
+func Infof(ctx context.Context, subsystem, format string, args ...interface{}) {
+	Log(ctx, hclog.Info, subsystem, fmt.Sprintf(format, args...), nil)
+}
+
+// Warnf logs a message at warn level to a subsystem.
+func Warnf(ctx context.Context, subsystem, format string, args ...interface{}) {
+	Log(ctx, hclog.Warn, subsystem, fmt.Sprintf(format, args...), nil)
+}
+
+// Errorf logs a message at error level to a subsystem.
+func Errorf(ctx context.Context, subsystem, format string, args ...interface{}) {
+	Log(ctx, hclog.Error, subsystem, fmt.Sprintf(format, args...), nil)
+}
+
+// Log masks a message and its fields, then writes them to a subsystem and the export file. The context returned by
+// the last call to Configure is used instead of ctx if ctx holds no settings.
+func Log(ctx context.Context, level hclog.Level, subsystem, msg string, fields map[string]interface{}) {
+	s, ok := ctx.Value(settingsKey{}).(*settings)
+	if !ok {
+		fallbackMu.RLock()
+		ctx = fallback
+		fallbackMu.RUnlock()
+		s = ctx.Value(settingsKey{}).(*settings)
+	}
+
+	if s.hide {
+		msg = maskMessage(msg)
+		fields = maskFields(fields)
+	}
+
+	switch level {
+	case hclog.Trace:
+		tflog.SubsystemTrace(ctx, subsystem, msg, fields)
+	case hclog.Debug:
+		tflog.SubsystemDebug(ctx, subsystem, msg, fields)
+	case hclog.Info:
+		tflog.SubsystemInfo(ctx, subsystem, msg, fields)
+	case hclog.Warn:
+		tflog.SubsystemWarn(ctx, subsystem, msg, fields)
+	default:
+		tflog.SubsystemError(ctx, subsystem, msg, fields)
+	}
+
+	if s.export != nil && level >= levelFromEnv(subsystem) {
+		s.export.write(time.Now(), level, subsystem, msg, fields)
+	}
+}
+
+// levelFromEnv returns the level a subsystem logs at, as set by TF_LOG_PROVIDER_JAMFPRO_<SUBSYSTEM>,
+// TF_LOG_PROVIDER or TF_LOG, and info if none is set.
+func levelFromEnv(subsystem string) hclog.Level {
+	for _, name := range []string{envVarLevelPrefix + "_" + strings.ToUpper(subsystem), "TF_LOG_PROVIDER", "TF_LOG"} {
+		if value := os.Getenv(name); value != "" {
+			if strings.EqualFold(value, "json") {
+				return hclog.Trace
+			}
+			if level := hclog.LevelFromString(value); level != hclog.NoLevel {
+				return level
+			}
+		}
+	}
+	return hclog.Info
+}
+
+// maskMessage replaces the secrets matched by sensitiveMessagePatterns.
+func maskMessage(msg string) string {
+	for _, pattern := range sensitiveMessagePatterns {
+		msg = pattern.ReplaceAllString(msg, "${1}"+maskedValue)
+	}
+	return msg
+}
+
+// maskFields returns a copy of fields with the values of sensitive keys replaced and secrets within string values
+// masked.
+func maskFields(fields map[string]interface{}) map[string]interface{} {
+	if len(fields) == 0 {
+		return fields
+	}
+
+	masked := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		switch {
+		case isSensitiveKey(key):
+			masked[key] = maskedValue
+		case isString(value):
+			masked[key] = maskMessage(fmt.Sprint(value))
+		default:
+			masked[key] = value
+		}
+	}
+	return masked
+}
+
+// isSensitiveKey reports whether a field key names a credential.
+func isSensitiveKey(key string) bool {
+	for _, sensitive := range sensitiveFieldKeys {
+		if strings.EqualFold(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// isString reports whether a field value is a string.
+func isString(value interface{}) bool {
+	_, ok := value.(string)
+	return ok
+}
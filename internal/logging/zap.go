@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// NewZapLogger returns a zap logger, as the Jamf Pro SDK and HTTP client expect, which writes to the http
+// subsystem with ctx. Levels are left to the subsystem, so every entry is passed on.
+func NewZapLogger(ctx context.Context) *zap.Logger {
+	return zap.New(&bridgeCore{ctx: ctx})
+}
+
+// bridgeCore is a zapcore.Core handing entries to Log.
+type bridgeCore struct {
+	ctx    context.Context
+	fields []zapcore.Field
+}
+
+// Enabled implements zapcore.LevelEnabler.
+func (c *bridgeCore) Enabled(zapcore.Level) bool {
+	return true
+}
+
+// With returns a core adding fields to every entry.
+func (c *bridgeCore) With(fields []zapcore.Field) zapcore.Core {
+	merged := make([]zapcore.Field, 0, len(c.fields)+len(fields))
+	merged = append(merged, c.fields...)
+	merged = append(merged, fields...)
+	return &bridgeCore{ctx: c.ctx, fields: merged}
+}
+
+// Check adds the core to entries about to be written.
+func (c *bridgeCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checked.AddCore(entry, c)
+}
+
+// Write logs an entry and its fields to the http subsystem.
+func (c *bridgeCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(encoder)
+	}
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+
+	Log(c.ctx, levelFromZap(entry.Level), SubsystemHTTP, entry.Message, encoder.Fields)
+	return nil
+}
+
+// Sync implements zapcore.Core. Nothing is buffered.
+func (c *bridgeCore) Sync() error {
+	return nil
+}
+
+// levelFromZap returns the hclog level of a zap level. Levels above error, which end the program in zap, are
+// logged as errors.
+func levelFromZap(level zapcore.Level) hclog.Level {
+	switch {
+	case level < zapcore.InfoLevel:
+		return hclog.Debug
+	case level == zapcore.InfoLevel:
+		return hclog.Info
+	case level == zapcore.WarnLevel:
+		return hclog.Warn
+	default:
+		return hclog.Error
+	}
+}
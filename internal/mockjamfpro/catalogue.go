@@ -0,0 +1,331 @@
+// mockjamfpro/catalogue.go
+// This file declares the object types known to the server. Supporting a further type is a matter of adding an
+// entry to classicCatalogue or proCatalogue, describing where it lives and how its ID and name are found.
+
+package mockjamfpro
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ClassicType describes a Classic API object type.
+type ClassicType struct {
+	// Path is the collection below /JSSResource, e.g. "policies".
+	Path string
+	// Element is the root element of a single object, e.g. "policy".
+	Element string
+	// ListElement is the element of each entry of the list response, if it differs from Element.
+	ListElement string
+	// IDPath and NamePath locate the ID and name within an object, e.g. "general/id". They default to "id" and "name".
+	IDPath   string
+	NamePath string
+	// IDSegment and NameSegment are the path segments preceding an ID or name in a URL. They default to "id" and "name".
+	IDSegment   string
+	NameSegment string
+	// ListFields are further paths within an object copied into its list entry, under their last element name.
+	ListFields []string
+	// ParentSegment is the path segment preceding the ID of a parent object below which objects are created, e.g.
+	// "softwaretitleconfig" for patch policies, which are created through /softwaretitleconfig/id/{id}.
+	ParentSegment string
+	// ParentIDPath locates the ID of the parent object within an object created below it.
+	ParentIDPath string
+	// Singleton defines whether the type is a settings document read and replaced at Path itself.
+	Singleton bool
+	// ListSection is the element the list response at Path nests the entries of the type in, for types sharing
+	// their Path with others, e.g. "users" for accounts. Such types are told apart by their ID and name segments,
+	// and creates to Path itself go to the first of them.
+	ListSection string
+	// UnescapedPaths are paths within an object whose text Jamf Pro HTML unescapes when it is written, such as the
+	// payloads of configuration profiles, which are sent escaped.
+	UnescapedPaths []string
+}
+
+func (c ClassicType) listElement() string { return orDefault(c.ListElement, c.Element) }
+func (c ClassicType) idPath() string      { return orDefault(c.IDPath, "id") }
+func (c ClassicType) namePath() string    { return orDefault(c.NamePath, "name") }
+func (c ClassicType) idSegment() string   { return orDefault(c.IDSegment, "id") }
+func (c ClassicType) nameSegment() string { return orDefault(c.NameSegment, "name") }
+
+// ProAction serves a request to a sub path of a Jamf Pro API object, e.g. POST /api/v1/packages/{id}/upload.
+// The object may be modified in place.
+type ProAction func(object proObject, w http.ResponseWriter, r *http.Request)
+
+// ProType describes a Jamf Pro API object type.
+type ProType struct {
+	// Path is the collection path, e.g. "/api/v1/categories".
+	Path string
+	// Aliases are further collection paths serving the same objects, as Jamf Pro serves some sub paths of a type
+	// from an older version of its API.
+	Aliases []string
+	// NameField is the JSON field holding the unique name of an object.
+	NameField string
+	// NumericID defines whether IDs are JSON numbers rather than strings.
+	NumericID bool
+	// CreateReturnsObject defines whether a create returns the whole object rather than its ID and href.
+	CreateReturnsObject bool
+	// VersionLock defines whether the type uses optimistic locking through a versionLock field.
+	VersionLock bool
+	// UnpagedList defines whether the list at Path returns every object as a JSON array rather than a page of
+	// results.
+	UnpagedList bool
+	// Defaults are set on created objects which do not carry them.
+	Defaults map[string]interface{}
+	// ReadOnlyFields are kept from the stored object when it is replaced.
+	ReadOnlyFields []string
+	// OnCreate fills in any fields Jamf Pro generates for a new object.
+	OnCreate func(object proObject)
+	// Actions serve sub paths of an object, keyed by method and sub path, e.g. "POST upload".
+	Actions map[string]ProAction
+}
+
+// classicCatalogue lists the Classic API types known to the server.
+var classicCatalogue = []ClassicType{
+	{Path: "accounts", Element: "account", ListElement: "user", IDSegment: "userid", NameSegment: "username", ListSection: "users"},
+	{Path: "accounts", Element: "group", IDSegment: "groupid", NameSegment: "groupname", ListSection: "groups"},
+	{Path: "activationcode", Element: "activation_code", Singleton: true},
+	{Path: "advancedcomputersearches", Element: "advanced_computer_search"},
+	{Path: "advancedmobiledevicesearches", Element: "advanced_mobile_device_search"},
+	{Path: "advancedusersearches", Element: "advanced_user_search"},
+	{Path: "allowedfileextensions", Element: "allowed_file_extension", NamePath: "extension", NameSegment: "extension", ListFields: []string{"extension"}},
+	{Path: "computercheckin", Element: "computer_check_in", Singleton: true},
+	{Path: "computerextensionattributes", Element: "computer_extension_attribute"},
+	{Path: "computergroups", Element: "computer_group", ListFields: []string{"is_smart"}},
+	{Path: "computerinventorycollection", Element: "computer_inventory_collection", Singleton: true},
+	{Path: "diskencryptionconfigurations", Element: "disk_encryption_configuration"},
+	{Path: "distributionpoints", Element: "distribution_point"},
+	{Path: "dockitems", Element: "dock_item"},
+	{
+		Path:           "mobiledeviceconfigurationprofiles",
+		Element:        "configuration_profile",
+		IDPath:         "general/id",
+		NamePath:       "general/name",
+		UnescapedPaths: []string{"general/payloads"},
+	},
+	{Path: "mobiledeviceextensionattributes", Element: "mobile_device_extension_attribute"},
+	{Path: "mobiledevicegroups", Element: "mobile_device_group", ListFields: []string{"is_smart"}},
+	{Path: "networksegments", Element: "network_segment", ListFields: []string{"starting_address", "ending_address"}},
+	{
+		Path:           "osxconfigurationprofiles",
+		Element:        "os_x_configuration_profile",
+		IDPath:         "general/id",
+		NamePath:       "general/name",
+		UnescapedPaths: []string{"general/payloads"},
+	},
+	{
+		Path:          "patchpolicies",
+		Element:       "patch_policy",
+		IDPath:        "general/id",
+		NamePath:      "general/name",
+		ParentSegment: "softwaretitleconfig",
+		ParentIDPath:  "software_title_configuration_id",
+	},
+	{Path: "policies", Element: "policy", IDPath: "general/id", NamePath: "general/name"},
+	{Path: "printers", Element: "printer"},
+	{Path: "restrictedsoftware", Element: "restricted_software", ListElement: "restricted_software_title", IDPath: "general/id", NamePath: "general/name"},
+	{Path: "sites", Element: "site"},
+	{Path: "usergroups", Element: "user_group", ListFields: []string{"is_smart"}},
+	{Path: "webhooks", Element: "webhook"},
+}
+
+// proCatalogue lists the Jamf Pro API types known to the server.
+var proCatalogue = []ProType{
+	{
+		Path:                "/api/v1/api-integrations",
+		NameField:           "displayName",
+		NumericID:           true,
+		CreateReturnsObject: true,
+		Defaults:            map[string]interface{}{"appType": "CLIENT_CREDENTIALS"},
+		ReadOnlyFields:      []string{"clientId", "appType"},
+		OnCreate: func(object proObject) {
+			object["clientId"] = randomHex(16)
+		},
+		Actions: map[string]ProAction{
+			"POST client-credentials": serveClientCredentials,
+		},
+	},
+	{Path: "/api/v1/api-roles", NameField: "displayName", CreateReturnsObject: true},
+	{Path: "/api/v1/buildings", NameField: "name"},
+	{Path: "/api/v1/categories", NameField: "name"},
+	{Path: "/api/v1/departments", NameField: "name"},
+	{
+		Path:           "/api/v1/packages",
+		NameField:      "packageName",
+		ReadOnlyFields: []string{"md5", "sha256", "hashType", "hashValue", "size"},
+		Actions: map[string]ProAction{
+			"POST upload": servePackageUpload,
+		},
+	},
+	{Path: "/api/v1/scripts", NameField: "name"},
+	{Path: "/api/v2/patch-software-title-configurations", NameField: "displayName", UnpagedList: true},
+	{
+		Path:           "/api/v3/computer-prestages",
+		Aliases:        []string{"/api/v2/computer-prestages"},
+		NameField:      "displayName",
+		VersionLock:    true,
+		ReadOnlyFields: []string{prestageScopeField},
+		Actions:        prestageScopeActions,
+	},
+	{
+		Path:           "/api/v2/mobile-device-prestages",
+		NameField:      "displayName",
+		VersionLock:    true,
+		ReadOnlyFields: []string{prestageScopeField},
+		Actions:        prestageScopeActions,
+	},
+}
+
+// prestageScopeField is the field of a prestage enrollment holding its scope, which Jamf Pro serves separately.
+const prestageScopeField = "_scope"
+
+// prestageScopeActions serve the scope of a prestage enrollment, the serial numbers of the devices assigned to it.
+// The scope has a version lock of its own, so a change carrying a stale one is rejected with a 409.
+var prestageScopeActions = map[string]ProAction{
+	"GET scope":                  servePrestageScope,
+	"PUT scope":                  servePrestageScopeChange(replaceSerialNumbers),
+	"POST scope":                 servePrestageScopeChange(addSerialNumbers),
+	"POST scope/delete-multiple": servePrestageScopeChange(removeSerialNumbers),
+}
+
+// prestageScope returns the serial numbers and version lock of the scope of a prestage enrollment.
+func prestageScope(object proObject) ([]interface{}, int) {
+	scope, _ := object[prestageScopeField].(proObject)
+	serialNumbers, _ := scope["serialNumbers"].([]interface{})
+	return serialNumbers, versionLock(scope)
+}
+
+// servePrestageScope returns the scope of a prestage enrollment as Jamf Pro does, one assignment per serial number.
+func servePrestageScope(object proObject, w http.ResponseWriter, r *http.Request) {
+	serialNumbers, version := prestageScope(object)
+
+	assignments := make([]map[string]interface{}, 0, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		assignments = append(assignments, map[string]interface{}{
+			"serialNumber":   serialNumber,
+			"assignmentDate": "2024-01-01T00:00:00Z",
+			"userAssigned":   "terraform",
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"prestageId":  object["id"],
+		"assignments": assignments,
+		"versionLock": version,
+	})
+}
+
+// servePrestageScopeChange returns an action changing the serial numbers of the scope of a prestage enrollment,
+// combining those in scope with those of the request through change.
+func servePrestageScopeChange(change func(current, requested []interface{}) []interface{}) ProAction {
+	return func(object proObject, w http.ResponseWriter, r *http.Request) {
+		update, ok := readJSON(w, r)
+		if !ok {
+			return
+		}
+
+		current, version := prestageScope(object)
+		if versionLock(update) != version {
+			writeJSONError(w, http.StatusConflict, "OPTIMISTIC_LOCK_FAILED", "versionLock")
+			return
+		}
+
+		requested, _ := update["serialNumbers"].([]interface{})
+		object[prestageScopeField] = proObject{"serialNumbers": change(current, requested), "versionLock": version + 1}
+
+		servePrestageScope(object, w, r)
+	}
+}
+
+// replaceSerialNumbers keeps the requested serial numbers only.
+func replaceSerialNumbers(_, requested []interface{}) []interface{} {
+	return requested
+}
+
+// addSerialNumbers adds the requested serial numbers which are not in scope yet.
+func addSerialNumbers(current, requested []interface{}) []interface{} {
+	serialNumbers := append([]interface{}{}, current...)
+	for _, serialNumber := range requested {
+		if !containsValue(serialNumbers, serialNumber) {
+			serialNumbers = append(serialNumbers, serialNumber)
+		}
+	}
+	return serialNumbers
+}
+
+// removeSerialNumbers removes the requested serial numbers from those in scope.
+func removeSerialNumbers(current, requested []interface{}) []interface{} {
+	serialNumbers := make([]interface{}, 0, len(current))
+	for _, serialNumber := range current {
+		if !containsValue(requested, serialNumber) {
+			serialNumbers = append(serialNumbers, serialNumber)
+		}
+	}
+	return serialNumbers
+}
+
+// containsValue reports whether values holds value.
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// serveClientCredentials issues a new client secret for an API integration.
+func serveClientCredentials(object proObject, w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"clientId":     object["clientId"],
+		"clientSecret": randomHex(24),
+	})
+}
+
+// servePackageUpload accepts the file of a package and records its name, size and hashes as Jamf Pro does.
+func servePackageUpload(object proObject, w http.ResponseWriter, r *http.Request) {
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_FILE", "file")
+		return
+	}
+	defer file.Close()
+
+	// The http client sends files base64 encoded, which Jamf Pro decodes before storing them.
+	content := io.Reader(file)
+	if strings.EqualFold(header.Header.Get("Content-Transfer-Encoding"), "base64") {
+		content = base64.NewDecoder(base64.StdEncoding, file)
+	}
+
+	md5Hash, sha256Hash := md5.New(), sha256.New()
+	size, err := io.Copy(io.MultiWriter(md5Hash, sha256Hash), content)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_FILE", "file")
+		return
+	}
+
+	object["fileName"] = header.Filename
+	object["md5"] = hex.EncodeToString(md5Hash.Sum(nil))
+	object["sha256"] = hex.EncodeToString(sha256Hash.Sum(nil))
+	object["hashType"] = "MD5"
+	object["hashValue"] = object["md5"]
+	object["size"] = strconv.FormatInt(size, 10)
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"id":   object["id"],
+		"href": DefaultFQDN + "/api/v1/packages/" + object["id"].(string),
+	})
+}
+
+// orDefault returns value, or fallback if value is empty.
+func orDefault(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,371 @@
+// mockjamfpro/classic.go
+// This file serves the Classic API, storing every object as a generic XML tree.
+
+package mockjamfpro
+
+import (
+	"encoding/xml"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// classicAPIPrefix is the path prefix of every Classic API endpoint.
+const classicAPIPrefix = "/JSSResource/"
+
+// classicNotFoundBody is the body returned by the Classic API for objects which do not exist.
+const classicNotFoundBody = `<html><head><title>Status page</title></head><body><p>Not Found</p>` +
+	`<p>The server has not found anything matching the request URI</p></body></html>`
+
+// xmlNode is a generic XML element, used to store Classic API objects without knowing their schema.
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []*xmlNode `xml:",any"`
+}
+
+// find returns the element at a slash separated path below the node, or nil.
+func (n *xmlNode) find(path string) *xmlNode {
+	current := n
+	for _, name := range strings.Split(path, "/") {
+		var next *xmlNode
+		for _, child := range current.Children {
+			if child.XMLName.Local == name {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// ensure returns the element at a slash separated path below the node, creating any missing elements.
+func (n *xmlNode) ensure(path string) *xmlNode {
+	current := n
+	for _, name := range strings.Split(path, "/") {
+		next := current.find(name)
+		if next == nil {
+			next = &xmlNode{XMLName: xml.Name{Local: name}}
+			current.Children = append(current.Children, next)
+		}
+		current = next
+	}
+	return current
+}
+
+// value returns the text of the element at a slash separated path below the node, or "".
+func (n *xmlNode) value(path string) string {
+	if found := n.find(path); found != nil {
+		return strings.TrimSpace(found.Text)
+	}
+	return ""
+}
+
+// newTextNode returns an element holding only text.
+func newTextNode(name string, text string) *xmlNode {
+	return &xmlNode{XMLName: xml.Name{Local: name}, Text: text}
+}
+
+// classicStore holds the objects of a single Classic API type.
+type classicStore struct {
+	spec    ClassicType
+	objects map[int]*xmlNode
+	nextID  int
+}
+
+// newClassicStore returns an empty store for a Classic API type. Singletons start out as an empty document.
+func newClassicStore(spec ClassicType) *classicStore {
+	store := &classicStore{spec: spec, objects: make(map[int]*xmlNode), nextID: 1}
+	if spec.Singleton {
+		store.objects[0] = &xmlNode{XMLName: xml.Name{Local: spec.Element}}
+	}
+	return store
+}
+
+// byName returns the ID of the object with the given name, or 0.
+func (c *classicStore) byName(name string) int {
+	for id, object := range c.objects {
+		if object.value(c.spec.namePath()) == name {
+			return id
+		}
+	}
+	return 0
+}
+
+// serveClassic routes a Classic API request of the form /JSSResource/{type}[/{key}/{value}[/...]].
+func (s *Server) serveClassic(w http.ResponseWriter, r *http.Request) {
+	segments := pathSegments(strings.TrimPrefix(r.URL.EscapedPath(), classicAPIPrefix))
+
+	stores, ok := s.classic[segments[0]]
+	if !ok {
+		writeClassicNotFound(w)
+		return
+	}
+	store := stores[0]
+
+	if store.spec.Singleton {
+		store.serveSingleton(w, r)
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			serveList(w, segments[0], stores)
+		case http.MethodPost:
+			store.serveCreate(w, r, "")
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	if len(segments) < 3 {
+		writeClassicNotFound(w)
+		return
+	}
+
+	key, value := segments[1], segments[2]
+	for _, candidate := range stores {
+		if key == candidate.spec.idSegment() || key == candidate.spec.nameSegment() {
+			store = candidate
+			break
+		}
+	}
+
+	if store.spec.ParentSegment != "" && key == store.spec.ParentSegment && value == "id" && len(segments) == 4 && r.Method == http.MethodPost {
+		store.serveCreate(w, r, segments[3])
+		return
+	}
+
+	var id int
+	switch key {
+	case store.spec.idSegment():
+		if r.Method == http.MethodPost {
+			store.serveCreate(w, r, "")
+			return
+		}
+		id, _ = strconv.Atoi(value)
+	case store.spec.nameSegment():
+		id = store.byName(value)
+	default:
+		writeClassicNotFound(w)
+		return
+	}
+
+	object, ok := store.objects[id]
+	if !ok {
+		writeClassicNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeXML(w, http.StatusOK, object)
+	case http.MethodPut:
+		store.serveUpdate(w, r, id)
+	case http.MethodDelete:
+		delete(store.objects, id)
+		writeXML(w, http.StatusOK, store.idResponse(id))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveList returns the list of every object of the types stored at a path, ordered by ID. The entries of types
+// sharing the path are nested in their ListSection.
+func serveList(w http.ResponseWriter, path string, stores []*classicStore) {
+	list := &xmlNode{XMLName: xml.Name{Local: path}}
+
+	for _, store := range stores {
+		entries := store.listEntries()
+		if section := store.spec.ListSection; section != "" {
+			list.Children = append(list.Children, &xmlNode{XMLName: xml.Name{Local: section}, Children: entries})
+			continue
+		}
+		list.Children = append(list.Children, newTextNode("size", strconv.Itoa(len(entries))))
+		list.Children = append(list.Children, entries...)
+	}
+
+	writeXML(w, http.StatusOK, list)
+}
+
+// listEntries returns the list entries of every object of the type, ordered by ID.
+func (c *classicStore) listEntries() []*xmlNode {
+	ids := make([]int, 0, len(c.objects))
+	for id := range c.objects {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	entries := make([]*xmlNode, 0, len(ids))
+	for _, id := range ids {
+		object := c.objects[id]
+		item := &xmlNode{XMLName: xml.Name{Local: c.spec.listElement()}}
+		item.Children = append(item.Children,
+			newTextNode("id", strconv.Itoa(id)),
+			newTextNode("name", object.value(c.spec.namePath())),
+		)
+		for _, field := range c.spec.ListFields {
+			name := field[strings.LastIndex(field, "/")+1:]
+			item.Children = append(item.Children, newTextNode(name, object.value(field)))
+		}
+		entries = append(entries, item)
+	}
+
+	return entries
+}
+
+// serveCreate stores a new object, assigning it the next ID regardless of the ID in the request. The ID of the
+// parent object it is created below, if any, is stored at ParentIDPath.
+func (c *classicStore) serveCreate(w http.ResponseWriter, r *http.Request, parentID string) {
+	object, ok := readXML(w, r)
+	if !ok {
+		return
+	}
+
+	if name := object.value(c.spec.namePath()); name != "" && c.byName(name) != 0 {
+		writeClassicConflict(w, "Duplicate name")
+		return
+	}
+
+	c.unescape(object)
+
+	id := c.nextID
+	c.nextID++
+
+	object.ensure(c.spec.idPath()).Text = strconv.Itoa(id)
+	if parentID != "" {
+		object.ensure(c.spec.ParentIDPath).Text = parentID
+	}
+	c.objects[id] = object
+
+	writeXML(w, http.StatusCreated, c.idResponse(id))
+}
+
+// serveUpdate replaces a stored object, keeping its ID.
+func (c *classicStore) serveUpdate(w http.ResponseWriter, r *http.Request, id int) {
+	object, ok := readXML(w, r)
+	if !ok {
+		return
+	}
+
+	if name := object.value(c.spec.namePath()); name != "" {
+		if existing := c.byName(name); existing != 0 && existing != id {
+			writeClassicConflict(w, "Duplicate name")
+			return
+		}
+	}
+
+	c.unescape(object)
+	object.ensure(c.spec.idPath()).Text = strconv.Itoa(id)
+	c.objects[id] = object
+
+	writeXML(w, http.StatusCreated, c.idResponse(id))
+}
+
+// unescape HTML unescapes the text at the UnescapedPaths of an object being written.
+func (c *classicStore) unescape(object *xmlNode) {
+	for _, path := range c.spec.UnescapedPaths {
+		if node := object.find(path); node != nil {
+			node.Text = html.UnescapeString(node.Text)
+		}
+	}
+}
+
+// serveSingleton reads or replaces a settings type holding a single document.
+func (c *classicStore) serveSingleton(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeXML(w, http.StatusOK, c.objects[0])
+	case http.MethodPut:
+		object, ok := readXML(w, r)
+		if !ok {
+			return
+		}
+		c.objects[0] = object
+		writeXML(w, http.StatusCreated, object)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// idResponse returns the body the Classic API returns after changing an object, e.g. <policy><id>1</id></policy>.
+func (c *classicStore) idResponse(id int) *xmlNode {
+	return &xmlNode{
+		XMLName:  xml.Name{Local: c.spec.Element},
+		Children: []*xmlNode{newTextNode("id", strconv.Itoa(id))},
+	}
+}
+
+// pathSegments splits an escaped path into its unescaped segments.
+func pathSegments(escapedPath string) []string {
+	segments := strings.Split(strings.Trim(escapedPath, "/"), "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = unescaped
+		}
+	}
+	return segments
+}
+
+// readXML decodes the XML body of a request, writing a 400 response if it is invalid.
+func readXML(w http.ResponseWriter, r *http.Request) (*xmlNode, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeClassicError(w, http.StatusBadRequest, "Unable to read request body")
+		return nil, false
+	}
+
+	var object xmlNode
+	if err := xml.Unmarshal(body, &object); err != nil {
+		writeClassicError(w, http.StatusBadRequest, "Problem with XML: "+err.Error())
+		return nil, false
+	}
+
+	return &object, true
+}
+
+// writeXML writes an XML response in the format used by the Classic API.
+func writeXML(w http.ResponseWriter, status int, body *xmlNode) {
+	w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+	w.WriteHeader(status)
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(body)
+}
+
+// writeClassicNotFound writes the 404 response of the Classic API.
+func writeClassicNotFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+	w.WriteHeader(http.StatusNotFound)
+	io.WriteString(w, classicNotFoundBody)
+}
+
+// writeClassicConflict writes the 409 response the Classic API returns for conflicting objects.
+func writeClassicConflict(w http.ResponseWriter, message string) {
+	writeClassicError(w, http.StatusConflict, "Error: "+message)
+}
+
+// writeClassicError writes an HTML error response in the format used by the Classic API.
+func writeClassicError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+	w.WriteHeader(status)
+	io.WriteString(w, "<html><head><title>Status page</title></head><body><p>"+http.StatusText(status)+"</p><p>"+
+		xmlEscape(message)+"</p></body></html>")
+}
+
+// xmlEscape escapes text for inclusion in an XML or HTML document.
+func xmlEscape(text string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(text))
+	return b.String()
+}
@@ -0,0 +1,279 @@
+// mockjamfpro/pro.go
+// This file serves the Jamf Pro API, storing every object as a generic JSON document.
+
+package mockjamfpro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// rsqlEqualityFilter matches the only RSQL filter supported by the server, a single equality such as name=="Example".
+var rsqlEqualityFilter = regexp.MustCompile(`^([A-Za-z0-9_.]+)==(?:"([^"]*)"|([^;,"]*))$`)
+
+// proObject is a Jamf Pro API object as decoded from JSON.
+type proObject map[string]interface{}
+
+// proStore holds the objects of a single Jamf Pro API type.
+type proStore struct {
+	spec    ProType
+	objects map[int]proObject
+	nextID  int
+}
+
+// newProStore returns an empty store for a Jamf Pro API type.
+func newProStore(spec ProType) *proStore {
+	return &proStore{spec: spec, objects: make(map[int]proObject), nextID: 1}
+}
+
+// byName returns the ID of the object with the given name, or 0.
+func (p *proStore) byName(name string) int {
+	for id, object := range p.objects {
+		if fmt.Sprint(object[p.spec.NameField]) == name {
+			return id
+		}
+	}
+	return 0
+}
+
+// idValue returns the ID as it appears in JSON for the type.
+func (p *proStore) idValue(id int) interface{} {
+	if p.spec.NumericID {
+		return id
+	}
+	return strconv.Itoa(id)
+}
+
+// href returns the URL of an object as returned alongside its ID.
+func (p *proStore) href(id int) string {
+	return fmt.Sprintf("%s%s/%d", DefaultFQDN, p.spec.Path, id)
+}
+
+// servePro routes a Jamf Pro API request of the form {path}[/{id}[/{action}]] to the type with the longest matching
+// path, which may be one of its aliases.
+func (s *Server) servePro(w http.ResponseWriter, r *http.Request) {
+	var store *proStore
+	var matched string
+	for path, candidate := range s.pro {
+		if (r.URL.Path == path || strings.HasPrefix(r.URL.Path, path+"/")) && len(path) > len(matched) {
+			store, matched = candidate, path
+		}
+	}
+
+	if store == nil {
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "")
+		return
+	}
+
+	rest := pathSegments(strings.TrimPrefix(r.URL.EscapedPath(), matched))
+	if len(rest) == 1 && rest[0] == "" {
+		switch r.Method {
+		case http.MethodGet:
+			store.serveList(w, r)
+		case http.MethodPost:
+			store.serveCreate(w, r)
+		default:
+			writeJSONError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "")
+		}
+		return
+	}
+
+	id, err := strconv.Atoi(rest[0])
+	object, ok := store.objects[id]
+	if err != nil || !ok {
+		writeJSONError(w, http.StatusNotFound, "INVALID_ID", "id")
+		return
+	}
+
+	if len(rest) > 1 {
+		action, ok := store.spec.Actions[r.Method+" "+strings.Join(rest[1:], "/")]
+		if !ok {
+			writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "")
+			return
+		}
+		action(object, w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, object)
+	case http.MethodPut, http.MethodPatch:
+		store.serveUpdate(w, r, id)
+	case http.MethodDelete:
+		delete(store.objects, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeJSONError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "")
+	}
+}
+
+// serveList returns a page of the objects of the type, ordered by ID, in the paginated format of the Jamf Pro API,
+// or all of them as an array for types with an unpaged list. The sort parameter is ignored and the filter
+// parameter only supports a single equality.
+func (p *proStore) serveList(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, err := strconv.Atoi(query.Get("page-size"))
+	if err != nil || pageSize < 1 {
+		pageSize = 100
+	}
+
+	var field, want string
+	if filter := query.Get("filter"); filter != "" {
+		match := rsqlEqualityFilter.FindStringSubmatch(filter)
+		if match == nil {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_RSQL_FILTER_FIELD", "filter")
+			return
+		}
+		field, want = match[1], match[2]+match[3]
+	}
+
+	ids := make([]int, 0, len(p.objects))
+	for id, object := range p.objects {
+		if field != "" && fmt.Sprint(object[field]) != want {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	if p.spec.UnpagedList {
+		results := make([]proObject, 0, len(ids))
+		for _, id := range ids {
+			results = append(results, p.objects[id])
+		}
+		writeJSON(w, http.StatusOK, results)
+		return
+	}
+
+	results := make([]proObject, 0, pageSize)
+	for i := page * pageSize; i < len(ids) && i < (page+1)*pageSize; i++ {
+		results = append(results, p.objects[ids[i]])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"totalCount": len(ids),
+		"results":    results,
+	})
+}
+
+// serveCreate stores a new object, assigning it the next ID and any defaults of the type.
+func (p *proStore) serveCreate(w http.ResponseWriter, r *http.Request) {
+	object, ok := readJSON(w, r)
+	if !ok {
+		return
+	}
+
+	if name, ok := object[p.spec.NameField]; ok && p.byName(fmt.Sprint(name)) != 0 {
+		writeJSONError(w, http.StatusBadRequest, "DUPLICATE_FIELD", p.spec.NameField)
+		return
+	}
+
+	id := p.nextID
+	p.nextID++
+
+	for key, value := range p.spec.Defaults {
+		if _, ok := object[key]; !ok {
+			object[key] = value
+		}
+	}
+	if p.spec.VersionLock {
+		object["versionLock"] = 0
+	}
+	if p.spec.OnCreate != nil {
+		p.spec.OnCreate(object)
+	}
+
+	object["id"] = p.idValue(id)
+	p.objects[id] = object
+
+	if p.spec.CreateReturnsObject {
+		writeJSON(w, http.StatusCreated, object)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": object["id"], "href": p.href(id)})
+}
+
+// serveUpdate replaces a stored object for PUT requests or merges the request into it for PATCH requests.
+// Types with optimistic locking reject requests carrying a stale versionLock with a 409.
+func (p *proStore) serveUpdate(w http.ResponseWriter, r *http.Request, id int) {
+	update, ok := readJSON(w, r)
+	if !ok {
+		return
+	}
+
+	current := p.objects[id]
+
+	if name, ok := update[p.spec.NameField]; ok {
+		if existing := p.byName(fmt.Sprint(name)); existing != 0 && existing != id {
+			writeJSONError(w, http.StatusBadRequest, "DUPLICATE_FIELD", p.spec.NameField)
+			return
+		}
+	}
+
+	// The SDK omits a versionLock of 0, so a missing versionLock is taken as 0.
+	if p.spec.VersionLock && versionLock(update) != versionLock(current) {
+		writeJSONError(w, http.StatusConflict, "OPTIMISTIC_LOCK_FAILED", "versionLock")
+		return
+	}
+
+	object := update
+	if r.Method == http.MethodPatch {
+		object = current
+		for key, value := range update {
+			object[key] = value
+		}
+	}
+
+	for _, key := range p.spec.ReadOnlyFields {
+		if value, ok := current[key]; ok {
+			object[key] = value
+		}
+	}
+	if p.spec.VersionLock {
+		object["versionLock"] = versionLock(current) + 1
+	}
+
+	object["id"] = p.idValue(id)
+	p.objects[id] = object
+
+	writeJSON(w, http.StatusOK, object)
+}
+
+// versionLock returns the versionLock of an object, 0 if it has none.
+func versionLock(object proObject) int {
+	version, _ := strconv.Atoi(fmt.Sprint(object["versionLock"]))
+	return version
+}
+
+// readJSON decodes the JSON object in the body of a request, writing a 400 response if it is invalid.
+// Numbers are kept as json.Number so integers survive a round trip unchanged.
+func readJSON(w http.ResponseWriter, r *http.Request) (proObject, bool) {
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r.Body); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_BODY", "")
+		return nil, false
+	}
+
+	object := proObject{}
+	if buf.Len() == 0 {
+		return object, true
+	}
+
+	decoder := json.NewDecoder(&buf)
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_JSON", "")
+		return nil, false
+	}
+
+	return object, true
+}
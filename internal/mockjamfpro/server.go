@@ -0,0 +1,304 @@
+// mockjamfpro/server.go
+// Package mockjamfpro provides an in-process fake Jamf Pro server holding objects in memory, so the provider
+// can be exercised end to end without a live tenant. It serves the Classic API as XML, the Jamf Pro API as JSON
+// and the OAuth and basic auth token endpoints, and plugs into the http client through Server.Executor.
+
+package mockjamfpro
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+)
+
+const (
+	// DefaultFQDN is the instance FQDN the provider should be configured with when using Server.Executor.
+	// Requests are never sent over the network, so any host works, but this one reads clearly in test output.
+	DefaultFQDN = "https://mock.jamfcloud.com"
+	// DefaultClientID and DefaultClientSecret are the OAuth client credentials accepted by a new Server.
+	DefaultClientID     = "mock-client-id"
+	DefaultClientSecret = "mock-client-secret"
+	// DefaultUsername and DefaultPassword are the basic auth credentials accepted by a new Server.
+	DefaultUsername = "mock-admin"
+	DefaultPassword = "mock-password"
+	// DefaultMember is the only web app member behind the load balancer of a new Server.
+	DefaultMember = "mock-web-app-1"
+	// DefaultVersion is the Jamf Pro version reported by a new Server.
+	DefaultVersion = "11.5.0-t1714996541"
+
+	// loadBalancerCookieName is the cookie Jamf Cloud uses to pin a session to a web app member.
+	loadBalancerCookieName = "jpro-ingress"
+	// tokenLifetime is the lifetime of issued access tokens.
+	tokenLifetime = 20 * time.Minute
+)
+
+// Server is an in-memory Jamf Pro. It is safe for concurrent use.
+type Server struct {
+	// ClientID and ClientSecret are the OAuth client credentials accepted by the token endpoint.
+	ClientID     string
+	ClientSecret string
+	// Username and Password are the basic auth credentials accepted by the bearer token endpoint.
+	Username string
+	Password string
+	// Version is the Jamf Pro version reported by the version endpoint.
+	Version string
+
+	mu     sync.Mutex
+	tokens map[string]time.Time
+	// classic holds the stores of the Classic API types, keyed by path. Types sharing a path are listed in the order
+	// of the catalogue.
+	classic map[string][]*classicStore
+	pro     map[string]*proStore
+	// members are the web app members behind the load balancer. Requests whose jpro-ingress cookie names one of
+	// them are served by it, the others are spread over the members in turn.
+	members []string
+	// requests counts the requests received, keyed by method and path, for assertions in tests.
+	requests map[string]int
+	// memberRequests counts the requests served by each web app member.
+	memberRequests map[string]int
+	// nextMember is the member the next request without a valid jpro-ingress cookie is routed to.
+	nextMember int
+}
+
+// New returns a Server with the default credentials, knowing every object type in the catalogue and holding no objects.
+func New() *Server {
+	s := &Server{
+		ClientID:       DefaultClientID,
+		ClientSecret:   DefaultClientSecret,
+		Username:       DefaultUsername,
+		Password:       DefaultPassword,
+		Version:        DefaultVersion,
+		tokens:         make(map[string]time.Time),
+		classic:        make(map[string][]*classicStore),
+		pro:            make(map[string]*proStore),
+		requests:       make(map[string]int),
+		members:        []string{DefaultMember},
+		memberRequests: make(map[string]int),
+	}
+
+	for _, spec := range classicCatalogue {
+		s.classic[spec.Path] = append(s.classic[spec.Path], newClassicStore(spec))
+	}
+	for _, spec := range proCatalogue {
+		store := newProStore(spec)
+		for _, path := range append([]string{spec.Path}, spec.Aliases...) {
+			s.pro[path] = store
+		}
+	}
+
+	return s
+}
+
+// Executor returns an HTTP executor which hands every request to the server in process instead of sending it over
+// the network. Each call returns a new executor with its own cookie jar, as the provider uses one for authentication
+// and another for the API client.
+func (s *Server) Executor() httpclient.HTTPExecutor {
+	return &httpclient.ProdExecutor{Client: &http.Client{Transport: s}}
+}
+
+// RoundTrip implements http.RoundTripper by serving the request in process.
+func (s *Server) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	s.ServeHTTP(recorder, req)
+
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+// ServeHTTP routes a request to the token endpoints, the Classic API or the Jamf Pro API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests[r.Method+" "+r.URL.Path]++
+	member := s.route(r)
+	s.memberRequests[member]++
+	http.SetCookie(w, &http.Cookie{Name: loadBalancerCookieName, Value: member})
+
+	switch r.URL.Path {
+	case "/api/oauth/token":
+		s.serveOAuthToken(w, r)
+		return
+	case "/api/v1/auth/token":
+		s.serveBearerToken(w, r)
+		return
+	}
+
+	if !s.authorized(r) {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_TOKEN", "")
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/api/v1/auth/keep-alive":
+		s.serveBearerTokenKeepAlive(w, r)
+	case r.URL.Path == "/api/v1/auth/invalidate-token":
+		delete(s.tokens, bearerToken(r))
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == "/api/v1/jamf-pro-version" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]string{"version": s.Version})
+	case strings.HasPrefix(r.URL.Path, classicAPIPrefix):
+		s.serveClassic(w, r)
+	case strings.HasPrefix(r.URL.Path, "/api/"):
+		s.servePro(w, r)
+	default:
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "")
+	}
+}
+
+// RequestCount returns how many requests were received with the given method and path, e.g. "GET /api/v1/categories".
+func (s *Server) RequestCount(methodAndPath string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[methodAndPath]
+}
+
+// SetMembers replaces the web app members behind the load balancer, e.g. to cycle the member a client is pinned to.
+func (s *Server) SetMembers(members ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members = members
+	s.nextMember = 0
+}
+
+// MemberRequestCount returns how many requests the given web app member served.
+func (s *Server) MemberRequestCount(member string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.memberRequests[member]
+}
+
+// route returns the web app member serving a request: the one named by its jpro-ingress cookie if that member
+// still exists, otherwise the next member in turn, as a load balancer with sticky sessions does.
+func (s *Server) route(r *http.Request) string {
+	if cookie, err := r.Cookie(loadBalancerCookieName); err == nil {
+		for _, member := range s.members {
+			if member == cookie.Value {
+				return member
+			}
+		}
+	}
+
+	member := s.members[s.nextMember%len(s.members)]
+	s.nextMember++
+	return member
+}
+
+// serveOAuthToken issues an access token for valid client credentials.
+func (s *Server) serveOAuthToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "")
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	if r.PostForm.Get("client_id") != s.ClientID || r.PostForm.Get("client_secret") != s.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	token := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"scope":        "api-role:mock",
+		"token_type":   "Bearer",
+		"expires_in":   int(tokenLifetime.Seconds()),
+	})
+}
+
+// serveBearerToken issues an access token for valid basic auth credentials.
+func (s *Server) serveBearerToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "")
+		return
+	}
+
+	username, password, ok := r.BasicAuth()
+	if !ok || username != s.Username || password != s.Password {
+		writeJSONError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "")
+		return
+	}
+
+	token := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"token":   token,
+		"expires": s.tokens[token].UTC().Format(time.RFC3339),
+	})
+}
+
+// serveBearerTokenKeepAlive replaces the presented token with a new one.
+func (s *Server) serveBearerTokenKeepAlive(w http.ResponseWriter, r *http.Request) {
+	delete(s.tokens, bearerToken(r))
+
+	token := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"token":   token,
+		"expires": s.tokens[token].UTC().Format(time.RFC3339),
+	})
+}
+
+// issueToken creates and records a new access token.
+func (s *Server) issueToken() string {
+	token := randomHex(32)
+	s.tokens[token] = time.Now().Add(tokenLifetime)
+	return token
+}
+
+// authorized reports whether the request carries an unexpired access token issued by the server.
+func (s *Server) authorized(r *http.Request) bool {
+	expiry, ok := s.tokens[bearerToken(r)]
+	return ok && time.Now().Before(expiry)
+}
+
+// bearerToken returns the token of the Authorization header of the request, if any.
+func bearerToken(r *http.Request) string {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return ""
+	}
+	return token
+}
+
+// randomHex returns n random bytes as a hexadecimal string.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to read random bytes: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
+
+// writeJSON writes body as a JSON response.
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// writeJSONError writes an error in the format used by the Jamf Pro API.
+func writeJSONError(w http.ResponseWriter, status int, code string, field string) {
+	writeJSON(w, status, map[string]interface{}{
+		"httpStatus": status,
+		"errors": []map[string]interface{}{
+			{"code": code, "field": field, "description": http.StatusText(status)},
+		},
+	})
+}
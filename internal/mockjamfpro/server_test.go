@@ -0,0 +1,326 @@
+package mockjamfpro
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"go.uber.org/zap"
+)
+
+// newTestClient returns an SDK client authenticated against the server with OAuth.
+func newTestClient(t *testing.T, s *Server) *jamfpro.Client {
+	t.Helper()
+
+	logger := zap.NewNop().Sugar()
+	integration, err := jamfprointegration.BuildWithOAuth(DefaultFQDN, logger, time.Minute, s.ClientID, s.ClientSecret, true, s.Executor())
+	if err != nil {
+		t.Fatalf("failed to authenticate against mock server: %v", err)
+	}
+
+	config := httpclient.ClientConfig{
+		Integration:              integration,
+		Sugar:                    logger,
+		TokenRefreshBufferPeriod: time.Minute,
+		HTTPExecutor:             s.Executor(),
+	}
+
+	httpClient, err := config.Build()
+	if err != nil {
+		t.Fatalf("failed to build http client: %v", err)
+	}
+
+	return &jamfpro.Client{HTTP: httpClient}
+}
+
+func TestOAuthRejectsInvalidCredentials(t *testing.T) {
+	s := New()
+
+	_, err := jamfprointegration.BuildWithOAuth(DefaultFQDN, zap.NewNop().Sugar(), time.Minute, s.ClientID, "wrong", true, s.Executor())
+	if err == nil {
+		t.Fatal("expected an error for an invalid client secret")
+	}
+}
+
+func TestBasicAuth(t *testing.T) {
+	s := New()
+
+	_, err := jamfprointegration.BuildWithBasicAuth(DefaultFQDN, zap.NewNop().Sugar(), time.Minute, s.Username, s.Password, true, s.Executor())
+	if err != nil {
+		t.Fatalf("expected basic auth to succeed: %v", err)
+	}
+
+	_, err = jamfprointegration.BuildWithBasicAuth(DefaultFQDN, zap.NewNop().Sugar(), time.Minute, s.Username, "wrong", true, s.Executor())
+	if err == nil {
+		t.Fatal("expected an error for an invalid password")
+	}
+}
+
+func TestClassicObjectLifecycle(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	created, err := client.CreateSite(&jamfpro.SharedResourceSite{Name: "Example"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	id := strconv.Itoa(created.ID)
+
+	byName, err := client.GetSiteByName("Example")
+	if err != nil || byName.ID != created.ID {
+		t.Fatalf("get by name returned %+v, %v", byName, err)
+	}
+
+	if _, err := client.CreateSite(&jamfpro.SharedResourceSite{Name: "Example"}); err == nil {
+		t.Fatal("expected a duplicate name to be rejected")
+	}
+
+	if _, err := client.UpdateSiteByID(id, &jamfpro.SharedResourceSite{Name: "Renamed"}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	list, err := client.GetSites()
+	if err != nil || list.Size != 1 || list.Site[0].Name != "Renamed" {
+		t.Fatalf("list returned %+v, %v", list, err)
+	}
+
+	if err := client.DeleteSiteByID(id); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	_, err = client.GetSiteByID(id)
+	if err == nil || !strings.Contains(err.Error(), `"status_code":404`) {
+		t.Fatalf("expected a 404 after delete, got %v", err)
+	}
+}
+
+func TestClassicNestedID(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	policy := &jamfpro.ResourcePolicy{General: jamfpro.PolicySubsetGeneral{Name: "Example"}}
+	created, err := client.CreatePolicy(policy)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	read, err := client.GetPolicyByID(strconv.Itoa(created.ID))
+	if err != nil || read.General.ID != created.ID || read.General.Name != "Example" {
+		t.Fatalf("get returned %+v, %v", read, err)
+	}
+}
+
+func TestClassicCreateBelowParent(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	policy := &jamfpro.ResourcePatchPolicies{General: jamfpro.PatchPoliciesSubsetGeneral{Name: "Example"}}
+	if _, err := client.CreatePatchPolicy(policy, 7); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	read, err := client.GetPatchPoliciesByID("1")
+	if err != nil || read.General.Name != "Example" || read.SoftwareTitleConfigurationID != 7 {
+		t.Fatalf("get returned %+v, %v", read, err)
+	}
+}
+
+func TestClassicTypesSharingAPath(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	if _, err := client.CreateAccount(&jamfpro.ResourceAccount{Name: "Example"}); err != nil {
+		t.Fatalf("create account failed: %v", err)
+	}
+	if _, err := client.CreateAccountGroup(&jamfpro.ResourceAccountGroup{Name: "Example"}); err != nil {
+		t.Fatalf("create account group failed: %v", err)
+	}
+
+	account, err := client.GetAccountByName("Example")
+	if err != nil || account.ID != 1 {
+		t.Fatalf("get account returned %+v, %v", account, err)
+	}
+	group, err := client.GetAccountGroupByID("1")
+	if err != nil || group.Name != "Example" {
+		t.Fatalf("get account group returned %+v, %v", group, err)
+	}
+
+	list, err := client.GetAccounts()
+	if err != nil || len(list.Users) != 1 || len(list.Groups) != 1 {
+		t.Fatalf("expected one user and one group in the list, got %+v, %v", list, err)
+	}
+}
+
+func TestClassicUnescapedPaths(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	profile := &jamfpro.ResourceMacOSConfigurationProfile{
+		General: jamfpro.MacOSConfigurationProfileSubsetGeneral{Name: "Example", Payloads: "&lt;string&gt;A &amp;amp; B&lt;/string&gt;"},
+	}
+	created, err := client.CreateMacOSConfigurationProfile(profile)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	read, err := client.GetMacOSConfigurationProfileByID(strconv.Itoa(created.ID))
+	if err != nil || read.General.Payloads != "<string>A &amp; B</string>" {
+		t.Fatalf("expected the payloads to be unescaped once, got %+v, %v", read, err)
+	}
+}
+
+func TestProObjectLifecycle(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	for _, name := range []string{"One", "Two", "Three"} {
+		if _, err := client.CreateCategory(&jamfpro.ResourceCategory{Name: name, Priority: 9}); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+
+	if _, err := client.CreateCategory(&jamfpro.ResourceCategory{Name: "Two"}); err == nil {
+		t.Fatal("expected a duplicate name to be rejected")
+	}
+
+	category, err := client.GetCategoryByName("Two")
+	if err != nil || category.Id != "2" || category.Priority != 9 {
+		t.Fatalf("get by name returned %+v, %v", category, err)
+	}
+
+	if _, err := client.UpdateCategoryByID("2", &jamfpro.ResourceCategory{Name: "Two", Priority: 5}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	list, err := client.GetCategories("")
+	if err != nil || list.TotalCount != 3 || list.Results[1].Priority != 5 {
+		t.Fatalf("list returned %+v, %v", list, err)
+	}
+
+	if err := client.DeleteCategoryByID("2"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	if _, err := client.GetCategoryByID("2"); err == nil {
+		t.Fatal("expected an error after delete")
+	}
+}
+
+func TestProVersionLock(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	created, err := client.CreateComputerPrestage(&jamfpro.ResourceComputerPrestage{DisplayName: "Example"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	prestage, err := client.GetComputerPrestageByID(created.ID)
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+
+	updated, err := client.UpdateComputerPrestageByID(created.ID, prestage)
+	if err != nil || updated.VersionLock != prestage.VersionLock+1 {
+		t.Fatalf("update returned %+v, %v", updated, err)
+	}
+
+	if _, err := client.UpdateComputerPrestageByID(created.ID, prestage); err == nil || !strings.Contains(err.Error(), `"status_code":409`) {
+		t.Fatalf("expected a 409 for a stale versionLock, got %v", err)
+	}
+}
+
+func TestPrestageScope(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	created, err := client.CreateComputerPrestage(&jamfpro.ResourceComputerPrestage{DisplayName: "Example"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	// The scope of computer prestages is served below /api/v2, the prestages themselves below /api/v3.
+	path := "/api/v2/computer-prestages/" + created.ID + "/scope"
+	var scope jamfpro.ResponseDeviceScope
+	for _, change := range []struct {
+		method, path  string
+		serialNumbers []string
+	}{
+		{"POST", path, []string{"C02AAAAAAAAA", "C02BBBBBBBBB"}},
+		{"POST", path + "/delete-multiple", []string{"C02AAAAAAAAA"}},
+	} {
+		body := map[string]interface{}{"serialNumbers": change.serialNumbers, "versionLock": scope.VersionLock}
+		if _, err := client.HTTP.DoRequest(change.method, change.path, body, &scope); err != nil {
+			t.Fatalf("%s %s failed: %v", change.method, change.path, err)
+		}
+	}
+
+	read, err := client.GetDeviceScopeForComputerPrestageByID(created.ID)
+	if err != nil || read.VersionLock != 2 || len(read.Assignments) != 1 || read.Assignments[0].SerialNumber != "C02BBBBBBBBB" {
+		t.Fatalf("get scope returned %+v, %v", read, err)
+	}
+
+	stale := map[string]interface{}{"serialNumbers": []string{"C02CCCCCCCCC"}, "versionLock": 1}
+	if _, err := client.HTTP.DoRequest("POST", path, stale, &scope); err == nil || !strings.Contains(err.Error(), `"status_code":409`) {
+		t.Fatalf("expected a 409 for a stale versionLock, got %v", err)
+	}
+}
+
+func TestPackageUpload(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	created, err := client.CreatePackage(jamfpro.ResourcePackage{PackageName: "Example", FileName: "example.pkg", CategoryID: "-1"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "example.pkg")
+	if err := os.WriteFile(path, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.UploadPackage(created.ID, []string{path}); err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+
+	pkg, err := client.GetPackageByID(created.ID)
+	if err != nil || pkg.MD5 != "5d41402abc4b2a76b9719d911017c592" || pkg.Size != "5" {
+		t.Fatalf("get returned %+v, %v", pkg, err)
+	}
+}
+
+func TestApiIntegrationClientCredentials(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+
+	created, err := client.CreateApiIntegration(&jamfpro.ResourceApiIntegration{DisplayName: "Example", AuthorizationScopes: []string{"Role"}})
+	if err != nil || created.ClientID == "" {
+		t.Fatalf("create returned %+v, %v", created, err)
+	}
+
+	credentials, err := client.RefreshClientCredentialsByApiRoleID(strconv.Itoa(created.ID))
+	if err != nil || credentials.ClientID != created.ClientID || credentials.ClientSecret == "" {
+		t.Fatalf("client credentials returned %+v, %v", credentials, err)
+	}
+}
+
+func TestRejectsMissingToken(t *testing.T) {
+	s := New()
+
+	resp, err := s.Executor().Get(DefaultFQDN + "/api/v1/categories")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 401 {
+		t.Fatalf("expected 401 without a token, got %d", resp.StatusCode)
+	}
+}
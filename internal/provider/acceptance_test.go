@@ -0,0 +1,159 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccResourceLifecycles runs each resource of lifecycleCases through Terraform against the mock Jamf Pro,
+// importing it after both the create and the update. It needs a Terraform CLI and TF_ACC=1, for example:
+//
+//	TF_ACC=1 TF_ACC_TERRAFORM_PATH=$(which terraform) go test -tags acceptance ./internal/provider -run TestAcc
+func TestAccResourceLifecycles(t *testing.T) {
+	for _, tc := range lifecycleCases {
+		tc := tc
+		t.Run(tc.resourceType, func(t *testing.T) {
+			server := mockjamfpro.New()
+			address := tc.resourceType + ".test"
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: map[string]func() (*schema.Provider, error){
+					"jamfpro": func() (*schema.Provider, error) {
+						return newMockProvider(server), nil
+					},
+				},
+				CheckDestroy: testAccCheckDestroyed(server, tc.resourceType),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccConfig(server, tc.resourceType, tc.create),
+						Check:  resource.TestCheckResourceAttrSet(address, "id"),
+					},
+					{
+						ResourceName:            address,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: tc.importIgnore,
+					},
+					{
+						Config: testAccConfig(server, tc.resourceType, tc.update),
+						Check:  resource.TestCheckResourceAttrSet(address, "id"),
+					},
+					{
+						ResourceName:            address,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: tc.importIgnore,
+					},
+				},
+			})
+		})
+	}
+}
+
+// testAccCheckDestroyed checks that every resource of the type left in the final state no longer exists in the mock.
+func testAccCheckDestroyed(server *mockjamfpro.Server, resourceType string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		provider := newMockProvider(server)
+		if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(mockProviderConfig(server))); diags.HasError() {
+			return fmt.Errorf("failed to configure provider against mock Jamf Pro: %v", diags)
+		}
+
+		r := provider.ResourcesMap[resourceType]
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			refreshed, diags := r.RefreshWithoutUpgrade(context.Background(), rs.Primary, provider.Meta())
+			if diags.HasError() {
+				return fmt.Errorf("failed to read %s %s: %v", resourceType, rs.Primary.ID, diags)
+			}
+			if refreshed != nil && refreshed.ID != "" {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccConfig renders a provider block pointing at the mock and a single resource named "test" with the given
+// attributes. The resource schema decides whether a list of maps is rendered as nested blocks or as an attribute.
+func testAccConfig(server *mockjamfpro.Server, resourceType string, attributes map[string]interface{}) string {
+	var b strings.Builder
+
+	b.WriteString("provider \"jamfpro\" {\n")
+	writeHCLBody(&b, nil, mockProviderConfig(server), "  ")
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "resource %q \"test\" {\n", resourceType)
+	writeHCLBody(&b, Provider().ResourcesMap[resourceType].Schema, attributes, "  ")
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// writeHCLBody writes attributes and nested blocks in a stable order.
+func writeHCLBody(b *strings.Builder, schemaMap map[string]*schema.Schema, attributes map[string]interface{}, indent string) {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := attributes[key]
+
+		if s, ok := schemaMap[key]; ok {
+			if elem, ok := s.Elem.(*schema.Resource); ok {
+				for _, block := range value.([]interface{}) {
+					fmt.Fprintf(b, "%s%s {\n", indent, key)
+					writeHCLBody(b, elem.Schema, block.(map[string]interface{}), indent+"  ")
+					fmt.Fprintf(b, "%s}\n", indent)
+				}
+				continue
+			}
+		}
+
+		fmt.Fprintf(b, "%s%s = %s\n", indent, key, hclValue(value))
+	}
+}
+
+// hclValue renders a scalar, list or map as an HCL expression.
+func hclValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(v)
+		return fmt.Sprintf("%q", escaped)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, hclValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		items := make([]string, 0, len(v))
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%q = %s", key, hclValue(v[key])))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return fmt.Sprint(v)
+	}
+}
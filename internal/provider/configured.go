@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfversion"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// attachConfigured wraps the functions of every resource and data source so that the context they are called with
+// holds the logging subsystems and settings and the Jamf Pro server of configured, the context the provider was
+// configured with. Logs then carry the fields Terraform adds to the request and follow the settings of the provider
+// configuration the request is for, and version checks use the server it is connected to, even when several are
+// aliased. The context is passed on as it is while the provider is not configured.
+func attachConfigured(resources, dataSources map[string]*schema.Resource, configured func() context.Context) {
+	attach := func(ctx context.Context) context.Context {
+		c := configured()
+		if c == nil {
+			return ctx
+		}
+
+		ctx = logging.WithSettings(ctx, c)
+		if server, ok := jamfversion.ServerFromContext(c); ok {
+			ctx = jamfversion.WithServer(ctx, server)
+		}
+		return ctx
+	}
+
+	for _, r := range resources {
+		r.CreateContext = withContext(r.CreateContext, attach)
+		r.ReadContext = withContext(r.ReadContext, attach)
+		r.UpdateContext = withContext(r.UpdateContext, attach)
+		r.DeleteContext = withContext(r.DeleteContext, attach)
+		if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+			r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return customizeDiff(attach(ctx), diff, meta)
+			}
+		}
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			importState := r.Importer.StateContext
+			r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return importState(attach(ctx), d, meta)
+			}
+		}
+	}
+	for _, r := range dataSources {
+		r.ReadContext = withContext(r.ReadContext, attach)
+	}
+}
+
+// withContext returns a CRUD function calling operation with the context returned by attach, nil if operation is
+// nil.
+func withContext(operation crudFunc, attach func(context.Context) context.Context) crudFunc {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return operation(attach(ctx), d, meta)
+	}
+}
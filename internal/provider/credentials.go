@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	envVarOAuthClientSecretFile   = "JAMFPRO_CLIENT_SECRET_FILE"
+	envVarBasicAuthPasswordFile   = "JAMFPRO_BASIC_PASSWORD_FILE"
+	envVarCredentialProcess       = "JAMFPRO_CREDENTIAL_PROCESS"
+	envVarConfigFile              = "JAMFPRO_CONFIG_FILE"
+	envVarProfile                 = "JAMFPRO_PROFILE"
+	defaultProfileName            = "default"
+	credentialProcessTimeout      = time.Minute
+	credentialProcessVersion      = 1
+	credentialProcessOutputFormat = `{"Version": 1, "ClientId": "...", "ClientSecret": "..."}`
+)
+
+// profileSettings are the provider attributes which may be set in a profile of the config file.
+var profileSettings = []string{
+	"jamfpro_instance_fqdn",
+	"auth_method",
+	"client_id",
+	"client_secret",
+	"client_secret_file",
+	"basic_auth_username",
+	"basic_auth_password",
+	"basic_auth_password_file",
+	"credential_process",
+}
+
+// providerCredentials are the connection settings of the provider once every source has been consulted.
+type providerCredentials struct {
+	FQDN              string
+	AuthMethod        string
+	ClientID          string
+	ClientSecret      string
+	BasicAuthUsername string
+	BasicAuthPassword string
+}
+
+// credentialProcessOutput is the JSON document a credential_process prints to stdout.
+type credentialProcessOutput struct {
+	Version           int    `json:"Version"`
+	ClientID          string `json:"ClientId"`
+	ClientSecret      string `json:"ClientSecret"`
+	BasicAuthUsername string `json:"BasicAuthUsername"`
+	BasicAuthPassword string `json:"BasicAuthPassword"`
+}
+
+/*
+resolveCredentials works out the Jamf Pro instance, auth method and credentials of the provider.
+
+Each setting is taken from the first source defining it:
+
+ 1. the provider block,
+ 2. its JAMFPRO_* environment variable,
+ 3. the selected profile of the config file (config_file / JAMFPRO_CONFIG_FILE).
+
+The client secret and basic auth password are taken from the first source setting either them or their *_file
+variant, preferring the value itself within a source, so a secret file of the provider block is never passed over
+for a secret of the environment or profile. A secret still missing is then read from the output of
+credential_process. Settings of the profile may point to files or a process as well.
+
+Parameters:
+
+	ctx - The context bounding the credential process.
+	d   - The provider configuration.
+
+Returns:
+
+	The resolved credentials, along with error diagnostics naming every setting which could not be resolved.
+*/
+func resolveCredentials(ctx context.Context, d *schema.ResourceData) (*providerCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	profile, err := loadProfile(d.Get("config_file").(string), d.Get("profile").(string))
+	if err != nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Error reading Jamf Pro config file",
+			Detail:   err.Error(),
+		})
+	}
+
+	setting := func(key string) string {
+		if value := d.Get(key).(string); value != "" {
+			return value
+		}
+		return profile[key]
+	}
+
+	creds := &providerCredentials{
+		FQDN:       setting("jamfpro_instance_fqdn"),
+		AuthMethod: strings.ToLower(setting("auth_method")),
+	}
+
+	if creds.FQDN == "" {
+		diags = append(diags, missingSettingDiagnostic("jamfpro_instance_fqdn", envVarJamfProFQDN))
+	}
+
+	switch creds.AuthMethod {
+	case "oauth2":
+		creds.ClientID = setting("client_id")
+		creds.ClientSecret, err = secretSetting(d, profile, "client_secret", envVarOAuthClientSecret, envVarOAuthClientSecretFile)
+	case "basic":
+		creds.BasicAuthUsername = setting("basic_auth_username")
+		creds.BasicAuthPassword, err = secretSetting(d, profile, "basic_auth_password", envVarBasicAuthPassword, envVarBasicAuthPasswordFile)
+	case "":
+		return nil, append(diags, missingSettingDiagnostic("auth_method", envVarJamfProAuthMethod))
+	default:
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid auth method",
+			Detail:   fmt.Sprintf("auth_method must be either \"oauth2\" or \"basic\", got %q", creds.AuthMethod),
+		})
+	}
+
+	if err != nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Error reading Jamf Pro credentials file",
+			Detail:   err.Error(),
+		})
+	}
+
+	if process := setting("credential_process"); process != "" && !creds.complete() {
+		output, err := runCredentialProcess(ctx, process)
+		if err != nil {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error running credential_process",
+				Detail:   err.Error(),
+			})
+		}
+		creds.fillFrom(output)
+	}
+
+	switch creds.AuthMethod {
+	case "oauth2":
+		if creds.ClientID == "" {
+			diags = append(diags, missingSettingDiagnostic("client_id", envVarOAuthClientId))
+		}
+		if creds.ClientSecret == "" {
+			diags = append(diags, missingSettingDiagnostic("client_secret", envVarOAuthClientSecret))
+		}
+	case "basic":
+		if creds.BasicAuthUsername == "" {
+			diags = append(diags, missingSettingDiagnostic("basic_auth_username", envVarBasicAuthUsername))
+		}
+		if creds.BasicAuthPassword == "" {
+			diags = append(diags, missingSettingDiagnostic("basic_auth_password", envVarBasicAuthPassword))
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return creds, diags
+}
+
+// complete reports whether the credentials of the auth method are all known.
+func (c *providerCredentials) complete() bool {
+	if c.AuthMethod == "basic" {
+		return c.BasicAuthUsername != "" && c.BasicAuthPassword != ""
+	}
+	return c.ClientID != "" && c.ClientSecret != ""
+}
+
+// fillFrom sets the credentials which are still missing from the output of a credential process.
+func (c *providerCredentials) fillFrom(output *credentialProcessOutput) {
+	fill := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+
+	fill(&c.ClientID, output.ClientID)
+	fill(&c.ClientSecret, output.ClientSecret)
+	fill(&c.BasicAuthUsername, output.BasicAuthUsername)
+	fill(&c.BasicAuthPassword, output.BasicAuthPassword)
+}
+
+// missingSettingDiagnostic reports a required setting found in none of its sources.
+func missingSettingDiagnostic(key, envVar string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Error getting %s", key),
+		Detail: fmt.Sprintf("%s must be provided either in the Terraform configuration, as an environment variable (%s), "+
+			"in a profile of the config file (%s) or through credential_process", key, envVar, envVarConfigFile),
+	}
+}
+
+// secretSetting resolves a secret from the first of the provider block, the environment and the profile which sets
+// either the secret or the file holding it, under key and key + "_file".
+func secretSetting(d *schema.ResourceData, profile map[string]string, key, envVar, fileEnvVar string) (string, error) {
+	sources := [][2]string{
+		{blockSetting(d, key, envVar), blockSetting(d, key+"_file", fileEnvVar)},
+		{os.Getenv(envVar), os.Getenv(fileEnvVar)},
+		{profile[key], profile[key+"_file"]},
+	}
+
+	for _, source := range sources {
+		if source[0] != "" || source[1] != "" {
+			return settingOrFile(source[0], source[1])
+		}
+	}
+
+	return "", nil
+}
+
+// blockSetting returns a setting of the provider block. The schema defaults settings to their environment variable,
+// so a value matching the variable is deemed to come from the environment rather than the block.
+func blockSetting(d *schema.ResourceData, key, envVar string) string {
+	value := d.Get(key).(string)
+	if value == os.Getenv(envVar) {
+		return ""
+	}
+	return value
+}
+
+// settingOrFile returns value, or the contents of path without surrounding whitespace if value is empty.
+func settingOrFile(value, path string) (string, error) {
+	if value != "" || path == "" {
+		return value, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+// runCredentialProcess runs a command through the shell and decodes the credentials it prints to stdout.
+// Whatever the command prints to stderr is included in the error if it fails, stdout never is.
+func runCredentialProcess(ctx context.Context, command string) (*credentialProcessOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential process failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("credential process must print a JSON document such as %s to stdout, decoding it failed: %v", credentialProcessOutputFormat, err)
+	}
+
+	if output.Version != credentialProcessVersion {
+		return nil, fmt.Errorf("credential process printed Version %d, only Version %d is supported", output.Version, credentialProcessVersion)
+	}
+
+	return &output, nil
+}
+
+/*
+loadProfile reads the settings of a profile from a config file in INI format, e.g.
+
+	[default]
+	jamfpro_instance_fqdn = https://mycompany.jamfcloud.com
+	auth_method           = oauth2
+	client_id             = 00000000-0000-0000-0000-000000000000
+	client_secret_file    = /etc/vault/jamfpro-client-secret
+
+	[profile staging]
+	jamfpro_instance_fqdn = https://mycompany-staging.jamfcloud.com
+	credential_process    = /usr/local/bin/jamfpro-credentials staging
+
+Sections are named either after the profile or "profile" followed by its name. Lines starting with # or ; are
+comments.
+
+Parameters:
+
+	path    - The path of the config file. No settings are loaded if it is empty.
+	profile - The name of the profile to load, "default" if empty.
+
+Returns:
+
+	The settings of the profile keyed by provider attribute name, or an error if the file cannot be read, is
+	malformed, sets an unknown attribute or lacks the profile.
+*/
+func loadProfile(path, profile string) (map[string]string, error) {
+	if path == "" {
+		return map[string]string{}, nil
+	}
+	if profile == "" {
+		profile = defaultProfileName
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %v", path, err)
+	}
+	defer file.Close()
+
+	known := make(map[string]bool, len(profileSettings))
+	for _, key := range profileSettings {
+		known[key] = true
+	}
+
+	var settings map[string]string
+	var section string
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[1:len(line)-1]), "profile "))
+			if section == profile {
+				settings = map[string]string{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected a setting of the form key = value", path, lineNumber)
+		}
+		key = strings.TrimSpace(key)
+		if !known[key] {
+			return nil, fmt.Errorf("%s:%d: unknown setting %q", path, lineNumber, key)
+		}
+
+		if section == profile {
+			settings[key] = strings.TrimSpace(value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	if settings == nil {
+		return nil, fmt.Errorf("profile %q not found in %s", profile, path)
+	}
+
+	return settings, nil
+}
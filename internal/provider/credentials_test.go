@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// configureWith configures a provider against a new mock Jamf Pro with the given configuration.
+func configureWith(t *testing.T, server *mockjamfpro.Server, config map[string]interface{}) error {
+	t.Helper()
+
+	provider := newMockProvider(server)
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		return fmt.Errorf("%v", diags)
+	}
+	return nil
+}
+
+// writeTestFile writes content to a file in a temporary directory and returns its path.
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestProviderClientSecretFile(t *testing.T) {
+	server := mockjamfpro.New()
+
+	config := mockProviderConfig(server)
+	delete(config, "client_secret")
+	config["client_secret_file"] = writeTestFile(t, "client-secret", server.ClientSecret+"\n")
+
+	if err := configureWith(t, server, config); err != nil {
+		t.Fatalf("failed to configure provider with client_secret_file: %v", err)
+	}
+}
+
+func TestProviderClientSecretFilePrecedence(t *testing.T) {
+	server := mockjamfpro.New()
+
+	configFile := writeTestFile(t, "config", "[default]\nclient_secret = stale-profile-secret\n")
+	config := mockProviderConfig(server)
+	delete(config, "client_secret")
+	config["config_file"] = configFile
+	config["client_secret_file"] = writeTestFile(t, "client-secret", server.ClientSecret)
+
+	if err := configureWith(t, server, config); err != nil {
+		t.Fatalf("expected client_secret_file of the provider block to win over the profile client_secret: %v", err)
+	}
+
+	t.Setenv(envVarOAuthClientSecret, "stale-environment-secret")
+	if err := configureWith(t, server, config); err != nil {
+		t.Fatalf("expected client_secret_file of the provider block to win over %s: %v", envVarOAuthClientSecret, err)
+	}
+}
+
+func TestProviderConfigFileProfile(t *testing.T) {
+	server := mockjamfpro.New()
+
+	secretFile := writeTestFile(t, "client-secret", server.ClientSecret)
+	configFile := writeTestFile(t, "config", `# Jamf Pro instances
+[default]
+jamfpro_instance_fqdn = https://unused.jamfcloud.com
+auth_method = oauth2
+client_id = unused
+
+[profile staging]
+jamfpro_instance_fqdn = `+mockjamfpro.DefaultFQDN+`
+auth_method = oauth2
+client_id = `+server.ClientID+`
+client_secret_file = `+secretFile+`
+`)
+
+	config := map[string]interface{}{
+		"config_file":                          configFile,
+		"profile":                              "staging",
+		"mandatory_request_delay_milliseconds": 0,
+		"token_cache_dir":                      "",
+	}
+	if err := configureWith(t, server, config); err != nil {
+		t.Fatalf("failed to configure provider from a config file profile: %v", err)
+	}
+
+	config["profile"] = "production"
+	if err := configureWith(t, server, config); err == nil {
+		t.Fatal("expected configuring the provider with an unknown profile to fail")
+	}
+}
+
+func TestProviderCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credential process of this test is a POSIX shell command")
+	}
+
+	server := mockjamfpro.New()
+
+	config := mockProviderConfig(server)
+	delete(config, "client_secret")
+	config["credential_process"] = `printf '{"Version": 1, "ClientSecret": "%s"}' ` + server.ClientSecret
+
+	if err := configureWith(t, server, config); err != nil {
+		t.Fatalf("failed to configure provider with credential_process: %v", err)
+	}
+
+	config["credential_process"] = `echo "vault is sealed" >&2; exit 1`
+	err := configureWith(t, server, config)
+	if err == nil {
+		t.Fatal("expected a failing credential process to fail configuring the provider")
+	}
+	if !strings.Contains(err.Error(), "vault is sealed") {
+		t.Fatalf("expected the error to carry the stderr of the credential process, got %v", err)
+	}
+}
+
+func TestProviderRequiresCredentials(t *testing.T) {
+	server := mockjamfpro.New()
+
+	config := mockProviderConfig(server)
+	delete(config, "client_secret")
+
+	if err := configureWith(t, server, config); err == nil {
+		t.Fatal("expected configuring the provider without a client secret to fail")
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// readListDataSource reads a list data source with the given configuration and returns the names it stated.
+func readListDataSource(t *testing.T, provider *schema.Provider, name string, raw map[string]interface{}) []interface{} {
+	t.Helper()
+
+	dataSource := provider.DataSourcesMap[name]
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, raw)
+	if diags := dataSource.ReadContext(context.Background(), d, provider.Meta()); diags.HasError() {
+		t.Fatalf("failed to read %s: %v", name, diags)
+	}
+
+	return d.Get("names").([]interface{})
+}
+
+// TestListDataSourceDescribesOnlyWhenFiltering checks that items are only read one by one when filtering on
+// the category, which the printer list endpoint omits.
+func TestListDataSourceDescribesOnlyWhenFiltering(t *testing.T) {
+	provider, server := configureMockProvider(t)
+	client := provider.Meta().(*jamfpro.Client)
+
+	for i, category := range []string{"Office", "Lab", "Office"} {
+		printer := &jamfpro.ResourcePrinter{Name: fmt.Sprintf("tf-mock-printer-%d", i), Category: category}
+		if _, err := client.CreatePrinter(printer); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	describes := func() int {
+		return server.RequestCount("GET /JSSResource/printers/id/1") + server.RequestCount("GET /JSSResource/printers/id/2") +
+			server.RequestCount("GET /JSSResource/printers/id/3")
+	}
+
+	names := readListDataSource(t, provider, "jamfpro_printers", map[string]interface{}{})
+	if len(names) != 3 || describes() != 0 {
+		t.Fatalf("expected 3 printers without describing any, got %v after %d describes", names, describes())
+	}
+
+	names = readListDataSource(t, provider, "jamfpro_printers", map[string]interface{}{"category": "Office"})
+	want := []interface{}{"tf-mock-printer-0", "tf-mock-printer-2"}
+	if !reflect.DeepEqual(names, want) || describes() != 3 {
+		t.Fatalf("expected %v after 3 describes, got %v after %d describes", want, names, describes())
+	}
+}
+
+// TestFileShareDistributionPointsList checks that every distribution point is listed, which the list model of the
+// SDK cannot decode.
+func TestFileShareDistributionPointsList(t *testing.T) {
+	provider, _ := configureMockProvider(t)
+	client := provider.Meta().(*jamfpro.Client)
+
+	for _, name := range []string{"tf-mock-dp-b", "tf-mock-dp-a"} {
+		if _, err := client.CreateDistributionPoint(&jamfpro.ResourceFileShareDistributionPoint{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := readListDataSource(t, provider, "jamfpro_file_share_distribution_points", map[string]interface{}{})
+	if want := []interface{}{"tf-mock-dp-a", "tf-mock-dp-b"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+}
+
+// TestPatchAndAllowedFileExtensionLists checks the list data sources of types the SDK lists in a format Jamf Pro
+// does not return, or names by another field.
+func TestPatchAndAllowedFileExtensionLists(t *testing.T) {
+	provider, _ := configureMockProvider(t)
+	client := provider.Meta().(*jamfpro.Client)
+
+	if _, err := client.CreateAllowedFileExtension(&jamfpro.ResourceAllowedFileExtension{Extension: "pkg"}); err != nil {
+		t.Fatal(err)
+	}
+	category, err := client.CreateCategory(&jamfpro.ResourceCategory{Name: "Browsers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, configuration := range []jamfpro.ResourcePatchSoftwareTitleConfiguration{
+		{DisplayName: "tf-mock-patch-title-chrome", SoftwareTitleID: "3", CategoryID: category.ID, SiteID: "-1"},
+		{DisplayName: "tf-mock-patch-title-zoom", SoftwareTitleID: "4", CategoryID: "-1", SiteID: "-1"},
+	} {
+		if _, err := client.CreatePatchSoftwareTitleConfiguration(configuration); err != nil {
+			t.Fatal(err)
+		}
+	}
+	policy := &jamfpro.ResourcePatchPolicies{General: jamfpro.PatchPoliciesSubsetGeneral{Name: "tf-mock-patch-policy"}}
+	if _, err := client.CreatePatchPolicy(policy, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		raw  map[string]interface{}
+		want []interface{}
+	}{
+		"jamfpro_allowed_file_extensions":             {map[string]interface{}{}, []interface{}{"pkg"}},
+		"jamfpro_patch_policies":                      {map[string]interface{}{}, []interface{}{"tf-mock-patch-policy"}},
+		"jamfpro_patch_software_title_configurations": {map[string]interface{}{"category": "Browsers"}, []interface{}{"tf-mock-patch-title-chrome"}},
+	} {
+		if names := readListDataSource(t, provider, name, tc.raw); !reflect.DeepEqual(names, tc.want) {
+			t.Errorf("%s: expected %v, got %v", name, tc.want, names)
+		}
+	}
+}
+
+// TestListDataSourceDescribesFilterOnlyAttributes checks that the category and site of items are described as
+// filter-only for types whose list endpoint omits them, and only for those.
+func TestListDataSourceDescribesFilterOnlyAttributes(t *testing.T) {
+	provider := Provider()
+
+	for name, filterOnly := range map[string]bool{"jamfpro_policies": true, "jamfpro_scripts": false} {
+		items := provider.DataSourcesMap[name].Schema["items"].Elem.(*schema.Resource)
+		description := items.Schema["category"].Description
+		if described := strings.Contains(description, "Empty unless the `category` or `site` filter is set"); described != filterOnly {
+			t.Errorf("%s: expected the category to be described as filter-only %t, got %q", name, filterOnly, description)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+)
+
+// retryAfterExecutor wraps an HTTPExecutor and records any Retry-After header returned by Jamf Pro
+// so that the error classification used by the resource CRUD functions can honour it.
+type retryAfterExecutor struct {
+	httpclient.HTTPExecutor
+}
+
+// Do executes the request and records the Retry-After delay of the response, if present. A successful
+// response drops any hint left behind by an earlier failed attempt of the same request.
+func (e *retryAfterExecutor) Do(req *http.Request) (*http.Response, error) {
+	resp, err := e.HTTPExecutor.Do(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	source := req
+	if resp.Request != nil {
+		source = resp.Request
+	}
+
+	if resp.StatusCode < http.StatusBadRequest {
+		common.ForgetRequestHint(source.Method, source.URL.String())
+		return resp, err
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return resp, err
+	}
+
+	delay, ok := common.ParseRetryAfter(value, time.Now())
+	if !ok {
+		return resp, err
+	}
+
+	common.RecordRetryAfter(source.Method, source.URL.String(), delay)
+
+	return resp, err
+}
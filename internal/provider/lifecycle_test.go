@@ -0,0 +1,1102 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/configurationprofiles/datavalidators"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// lifecycleCase describes how to create and update a resource against the mock Jamf Pro.
+// The same cases drive the offline lifecycle test below and the acceptance tests run through resource.Test.
+type lifecycleCase struct {
+	// resourceType is the name of the resource, e.g. "jamfpro_category".
+	resourceType string
+	// create and update are the resource configurations applied in turn.
+	create map[string]interface{}
+	update map[string]interface{}
+	// importIgnore lists attributes which cannot be recovered by an import, such as write-only secrets.
+	importIgnore []string
+}
+
+// lifecycleCases lists the resources exercised against the mock Jamf Pro.
+var lifecycleCases = []lifecycleCase{
+	{
+		resourceType: "jamfpro_category",
+		create:       map[string]interface{}{"name": "tf-mock-category", "priority": 9},
+		update:       map[string]interface{}{"name": "tf-mock-category-renamed", "priority": 5},
+	},
+	{
+		resourceType: "jamfpro_building",
+		create:       map[string]interface{}{"name": "tf-mock-building", "city": "Minneapolis"},
+		update:       map[string]interface{}{"name": "tf-mock-building", "city": "Eau Claire", "country": "United States"},
+	},
+	{
+		resourceType: "jamfpro_department",
+		create:       map[string]interface{}{"name": "tf-mock-department"},
+		update:       map[string]interface{}{"name": "tf-mock-department-renamed"},
+	},
+	{
+		resourceType: "jamfpro_script",
+		create: map[string]interface{}{
+			"name":            "tf-mock-script",
+			"priority":        "BEFORE",
+			"script_contents": "#!/bin/sh\necho hello\n",
+		},
+		update: map[string]interface{}{
+			"name":            "tf-mock-script",
+			"priority":        "AFTER",
+			"script_contents": "#!/bin/sh\necho goodbye\n",
+			"parameter4":      "first",
+		},
+	},
+	{
+		resourceType: "jamfpro_site",
+		create:       map[string]interface{}{"name": "tf-mock-site"},
+		update:       map[string]interface{}{"name": "tf-mock-site-renamed"},
+	},
+	{
+		resourceType: "jamfpro_macos_configuration_profile_plist",
+		create: map[string]interface{}{
+			"name":     "tf-mock-macos-profile",
+			"payloads": mockProfilePayload("System", "Dock", "<key>autohide</key><true/>"),
+			"scope":    []interface{}{map[string]interface{}{"all_computers": true}},
+		},
+		update: map[string]interface{}{
+			"name":        "tf-mock-macos-profile",
+			"description": "Hides the Dock & magnifies it.",
+			"payloads":    mockProfilePayload("System", "Dock", "<key>autohide</key><true/><key>magnification</key><true/>"),
+			"scope":       []interface{}{map[string]interface{}{"all_computers": false, "computer_group_ids": []interface{}{1}}},
+		},
+	},
+	{
+		resourceType: "jamfpro_mobile_device_configuration_profile_plist",
+		create: map[string]interface{}{
+			"name":     "tf-mock-mobile-profile",
+			"level":    "Device Level",
+			"payloads": mockProfilePayload("System", "Wallpaper", "<key>where</key><integer>1</integer>"),
+			"scope":    []interface{}{map[string]interface{}{"all_mobile_devices": true}},
+		},
+		update: map[string]interface{}{
+			"name":     "tf-mock-mobile-profile",
+			"level":    "Device Level",
+			"payloads": mockProfilePayload("System", "Wallpaper", "<key>where</key><integer>3</integer>"),
+			"scope":    []interface{}{map[string]interface{}{"all_mobile_devices": false, "mobile_device_group_ids": []interface{}{1}}},
+		},
+	},
+	{
+		resourceType: "jamfpro_account",
+		create: map[string]interface{}{
+			"name":                   "tf-mock-account",
+			"enabled":                "Enabled",
+			"access_level":           "Full Access",
+			"privilege_set":          "Custom",
+			"password":               "tf-mock-password",
+			"jss_objects_privileges": []interface{}{"Read Buildings"},
+		},
+		update: map[string]interface{}{
+			"name":                   "tf-mock-account",
+			"full_name":              "Terraform Mock",
+			"enabled":                "Disabled",
+			"access_level":           "Full Access",
+			"privilege_set":          "Custom",
+			"password":               "tf-mock-password",
+			"jss_objects_privileges": []interface{}{"Read Buildings", "Read Departments"},
+		},
+		importIgnore: []string{"password"},
+	},
+	{
+		resourceType: "jamfpro_account_group",
+		create: map[string]interface{}{
+			"name":                   "tf-mock-account-group",
+			"access_level":           "Full Access",
+			"privilege_set":          "Custom",
+			"jss_objects_privileges": []interface{}{"Read Buildings"},
+		},
+		update: map[string]interface{}{
+			"name":                   "tf-mock-account-group-renamed",
+			"access_level":           "Full Access",
+			"privilege_set":          "Custom",
+			"jss_objects_privileges": []interface{}{"Read Buildings", "Read Departments"},
+		},
+	},
+	{
+		resourceType: "jamfpro_network_segment",
+		create: map[string]interface{}{
+			"name":             "tf-mock-network-segment",
+			"starting_address": "10.0.0.1",
+			"ending_address":   "10.0.0.254",
+		},
+		update: map[string]interface{}{
+			"name":             "tf-mock-network-segment",
+			"starting_address": "10.0.1.1",
+			"ending_address":   "10.0.1.254",
+		},
+	},
+	{
+		resourceType: "jamfpro_dock_item",
+		create: map[string]interface{}{
+			"name": "tf-mock-dock-item",
+			"type": "App",
+			"path": "file://localhost/Applications/Safari.app/",
+		},
+		update: map[string]interface{}{
+			"name": "tf-mock-dock-item",
+			"type": "App",
+			"path": "file://localhost/Applications/Calendar.app/",
+		},
+	},
+	{
+		resourceType: "jamfpro_webhook",
+		create: map[string]interface{}{
+			"name":         "tf-mock-webhook",
+			"enabled":      true,
+			"url":          "https://example.com/webhook",
+			"content_type": "application/json",
+			"event":        "ComputerAdded",
+		},
+		update: map[string]interface{}{
+			"name":         "tf-mock-webhook",
+			"enabled":      false,
+			"url":          "https://example.com/webhook",
+			"content_type": "text/xml",
+			"event":        "ComputerCheckIn",
+		},
+	},
+	{
+		resourceType: "jamfpro_computer_extension_attribute",
+		create: map[string]interface{}{
+			"name":       "tf-mock-extension-attribute",
+			"enabled":    true,
+			"input_type": "Text Field",
+		},
+		update: map[string]interface{}{
+			"name":        "tf-mock-extension-attribute",
+			"enabled":     true,
+			"description": "Updated",
+			"input_type":  "Pop-up Menu",
+			"input_popup": []interface{}{"one", "two"},
+		},
+	},
+	{
+		resourceType: "jamfpro_mobile_device_extension_attribute",
+		create: map[string]interface{}{
+			"name":        "tf-mock-mobile-extension-attribute",
+			"input_type":  "Pop-up Menu",
+			"input_popup": []interface{}{"one", "two"},
+		},
+		update: map[string]interface{}{
+			"name":                    "tf-mock-mobile-extension-attribute",
+			"description":             "Updated",
+			"inventory_display":       "User and Location",
+			"input_type":              "LDAP Attribute Mapping",
+			"input_directory_mapping": "department",
+		},
+	},
+	{
+		resourceType: "jamfpro_mobile_device_prestage_enrollment",
+		create: map[string]interface{}{
+			"display_name":                          "tf-mock-mobile-prestage",
+			"device_enrollment_program_instance_id": "1",
+			"skip_setup_items":                      []interface{}{map[string]interface{}{"location": true, "siri": true}},
+			"names": []interface{}{map[string]interface{}{
+				"assign_names_using": "Serial Numbers",
+				"device_name_prefix": "iPad-",
+			}},
+			"assigned_device_serial_numbers": []interface{}{"DMPXK2AAAAAA", "DMPXK2BBBBBB"},
+		},
+		update: map[string]interface{}{
+			"display_name":                          "tf-mock-mobile-prestage",
+			"device_enrollment_program_instance_id": "1",
+			"skip_setup_items":                      []interface{}{map[string]interface{}{"location": true, "passcode": true}},
+			"location_information":                  []interface{}{map[string]interface{}{"room": "Library"}},
+			"names": []interface{}{map[string]interface{}{
+				"assign_names_using": "List of Names",
+				"device_names":       []interface{}{"Cart-1", "Cart-2"},
+			}},
+			"multi_user":                     true,
+			"maximum_shared_accounts":        8,
+			"temporary_session_only":         true,
+			"assigned_device_serial_numbers": []interface{}{"DMPXK2BBBBBB", "DMPXK2CCCCCC"},
+		},
+	},
+	{
+		resourceType: "jamfpro_api_integration",
+		create: map[string]interface{}{
+			"display_name":         "tf-mock-api-integration",
+			"enabled":              true,
+			"authorization_scopes": []interface{}{"tf-mock-api-role"},
+		},
+		update: map[string]interface{}{
+			"display_name":                  "tf-mock-api-integration",
+			"enabled":                       false,
+			"access_token_lifetime_seconds": 600,
+			"authorization_scopes":          []interface{}{"tf-mock-api-role"},
+		},
+	},
+	{
+		resourceType: "jamfpro_smart_mobile_device_group",
+		create: map[string]interface{}{
+			"name": "tf-mock-smart-mobile-device-group",
+			"criteria": []interface{}{
+				map[string]interface{}{"name": "Model", "priority": 0, "search_type": "like", "value": "iPad"},
+			},
+		},
+		update: map[string]interface{}{
+			"name": "tf-mock-smart-mobile-device-group",
+			"criteria": []interface{}{
+				map[string]interface{}{"name": "Model", "priority": 0, "search_type": "like", "value": "iPad"},
+				map[string]interface{}{"name": "OS Version", "priority": 1, "and_or": "and", "search_type": "greater than or equal", "value": "17.0"},
+			},
+		},
+	},
+	{
+		resourceType: "jamfpro_static_mobile_device_group",
+		create:       map[string]interface{}{"name": "tf-mock-static-mobile-device-group", "assigned_mobile_device_ids": []interface{}{1}},
+		update:       map[string]interface{}{"name": "tf-mock-static-mobile-device-group", "assigned_mobile_device_ids": []interface{}{1, 2}},
+	},
+	{
+		resourceType: "jamfpro_patch_software_title_configuration",
+		create: map[string]interface{}{
+			"display_name":        "tf-mock-patch-title",
+			"software_title_id":   "3",
+			"extension_attribute": []interface{}{map[string]interface{}{"ea_id": "google-chrome-ea", "accepted": true}},
+			"package":             []interface{}{map[string]interface{}{"package_id": "1", "version": "124.0.6367.60"}},
+		},
+		update: map[string]interface{}{
+			"display_name":        "tf-mock-patch-title",
+			"software_title_id":   "3",
+			"email_notifications": true,
+			"extension_attribute": []interface{}{map[string]interface{}{"ea_id": "google-chrome-ea", "accepted": true}},
+			"package": []interface{}{
+				map[string]interface{}{"package_id": "1", "version": "124.0.6367.60"},
+				map[string]interface{}{"package_id": "2", "version": "125.0.6422.60"},
+			},
+		},
+	},
+	{
+		resourceType: "jamfpro_patch_policy",
+		create: map[string]interface{}{
+			"name":                            "tf-mock-patch-policy",
+			"enabled":                         true,
+			"software_title_configuration_id": "1",
+			"target_version":                  "124.0.6367.60",
+			"kill_app":                        []interface{}{map[string]interface{}{"kill_app_name": "Google Chrome.app", "kill_app_bundle_id": "com.google.Chrome"}},
+			"scope":                           []interface{}{map[string]interface{}{"all_computers": true}},
+			"grace_period":                    []interface{}{map[string]interface{}{"duration": 15, "message": "Chrome will quit in 15 minutes."}},
+		},
+		update: map[string]interface{}{
+			"name":                            "tf-mock-patch-policy",
+			"enabled":                         true,
+			"software_title_configuration_id": "1",
+			"target_version":                  "125.0.6422.60",
+			"distribution_method":             "selfservice",
+			"scope": []interface{}{map[string]interface{}{
+				"all_computers":      false,
+				"computer_group_ids": []interface{}{1, 2},
+				"exclusions":         []interface{}{map[string]interface{}{"computer_ids": []interface{}{3}}},
+			}},
+			"self_service": []interface{}{map[string]interface{}{
+				"description":      "Updates Google Chrome.",
+				"deadline_enabled": true,
+				"deadline_period":  3,
+			}},
+			"grace_period": []interface{}{map[string]interface{}{"duration": 30}},
+		},
+	},
+	{
+		resourceType: "jamfpro_api_role",
+		create:       map[string]interface{}{"display_name": "tf-mock-api-role", "privileges": []interface{}{"Read Computers"}},
+		update:       map[string]interface{}{"display_name": "tf-mock-api-role", "privileges": []interface{}{"Read Computers", "Read Buildings"}},
+	},
+	{
+		resourceType: "jamfpro_printer",
+		create:       map[string]interface{}{"name": "tf-mock-printer", "uri": "lpd://10.0.0.5/", "cups_name": "tf_mock_printer"},
+		update:       map[string]interface{}{"name": "tf-mock-printer", "uri": "lpd://10.0.0.6/", "cups_name": "tf_mock_printer", "location": "Level 2"},
+	},
+	{
+		resourceType: "jamfpro_smart_computer_group",
+		create: map[string]interface{}{
+			"name": "tf-mock-smart-computer-group",
+			"criteria": []interface{}{
+				map[string]interface{}{"name": "Operating System Version", "priority": 0, "search_type": "like", "value": "14."},
+			},
+		},
+		update: map[string]interface{}{
+			"name": "tf-mock-smart-computer-group",
+			"criteria": []interface{}{
+				map[string]interface{}{"name": "Operating System Version", "priority": 0, "search_type": "like", "value": "14."},
+				map[string]interface{}{"name": "Model", "priority": 1, "and_or": "and", "search_type": "like", "value": "MacBook"},
+			},
+		},
+	},
+	{
+		resourceType: "jamfpro_static_computer_group",
+		create:       map[string]interface{}{"name": "tf-mock-static-computer-group", "assigned_computer_ids": []interface{}{1}},
+		update:       map[string]interface{}{"name": "tf-mock-static-computer-group", "assigned_computer_ids": []interface{}{1, 2}},
+	},
+	{
+		resourceType: "jamfpro_user_group",
+		create:       map[string]interface{}{"name": "tf-mock-user-group", "is_smart": false, "assigned_user_ids": []interface{}{1}},
+		update:       map[string]interface{}{"name": "tf-mock-user-group", "is_smart": false, "assigned_user_ids": []interface{}{1, 2}},
+	},
+	{
+		resourceType: "jamfpro_advanced_computer_search",
+		create: map[string]interface{}{
+			"name":     "tf-mock-advanced-computer-search",
+			"criteria": []interface{}{map[string]interface{}{"name": "Computer Name", "priority": 0, "and_or": "and", "search_type": "like", "value": "lab-"}},
+		},
+		update: map[string]interface{}{
+			"name":           "tf-mock-advanced-computer-search",
+			"criteria":       []interface{}{map[string]interface{}{"name": "Computer Name", "priority": 0, "and_or": "and", "search_type": "like", "value": "lab-"}},
+			"display_fields": []interface{}{"Computer Name", "Serial Number"},
+		},
+	},
+	{
+		resourceType: "jamfpro_advanced_mobile_device_search",
+		create: map[string]interface{}{
+			"name":     "tf-mock-advanced-mobile-device-search",
+			"criteria": []interface{}{map[string]interface{}{"name": "Model", "priority": 0, "and_or": "and", "search_type": "like", "value": "iPad"}},
+		},
+		update: map[string]interface{}{
+			"name":           "tf-mock-advanced-mobile-device-search",
+			"criteria":       []interface{}{map[string]interface{}{"name": "Model", "priority": 0, "and_or": "and", "search_type": "like", "value": "iPad"}},
+			"display_fields": []interface{}{"Display Name", "Serial Number"},
+		},
+	},
+	{
+		resourceType: "jamfpro_advanced_user_search",
+		create: map[string]interface{}{
+			"name":     "tf-mock-advanced-user-search",
+			"criteria": []interface{}{map[string]interface{}{"name": "Email Address", "priority": 0, "and_or": "and", "search_type": "like", "value": "@example.com"}},
+		},
+		update: map[string]interface{}{
+			"name":           "tf-mock-advanced-user-search",
+			"criteria":       []interface{}{map[string]interface{}{"name": "Email Address", "priority": 0, "and_or": "and", "search_type": "like", "value": "@example.com"}},
+			"display_fields": []interface{}{"Username", "Email Address"},
+		},
+	},
+	{
+		resourceType: "jamfpro_restricted_software",
+		create: map[string]interface{}{
+			"name":         "tf-mock-restricted-software",
+			"process_name": "Chess.app",
+			"kill_process": true,
+			"scope":        []interface{}{map[string]interface{}{"all_computers": true}},
+		},
+		update: map[string]interface{}{
+			"name":            "tf-mock-restricted-software",
+			"process_name":    "Chess.app",
+			"kill_process":    true,
+			"display_message": "Chess is not allowed.",
+			"scope":           []interface{}{map[string]interface{}{"all_computers": false, "computer_group_ids": []interface{}{1}}},
+		},
+	},
+	{
+		resourceType: "jamfpro_disk_encryption_configuration",
+		create:       map[string]interface{}{"name": "tf-mock-disk-encryption", "key_type": "Individual", "file_vault_enabled_users": "Management Account"},
+		update:       map[string]interface{}{"name": "tf-mock-disk-encryption", "key_type": "Individual", "file_vault_enabled_users": "Current or Next User"},
+	},
+	{
+		resourceType: "jamfpro_file_share_distribution_point",
+		create: map[string]interface{}{
+			"name":            "tf-mock-distribution-point",
+			"ip_address":      "dp.example.com",
+			"connection_type": "SMB",
+			"share_name":      "CasperShare",
+		},
+		update: map[string]interface{}{
+			"name":            "tf-mock-distribution-point",
+			"ip_address":      "dp.example.com",
+			"connection_type": "SMB",
+			"share_name":      "CasperShare",
+			"share_port":      445,
+		},
+	},
+	{
+		resourceType: "jamfpro_policy",
+		create: map[string]interface{}{
+			"name":            "tf-mock-policy",
+			"enabled":         true,
+			"trigger_checkin": true,
+			"scope":           []interface{}{map[string]interface{}{"all_computers": true}},
+			"payloads": []interface{}{map[string]interface{}{
+				"maintenance": []interface{}{map[string]interface{}{"recon": true}},
+			}},
+		},
+		update: map[string]interface{}{
+			"name":            "tf-mock-policy",
+			"enabled":         false,
+			"trigger_checkin": true,
+			"frequency":       "Ongoing",
+			"scope":           []interface{}{map[string]interface{}{"all_computers": false, "computer_group_ids": []interface{}{1}}},
+			"payloads": []interface{}{map[string]interface{}{
+				"maintenance":     []interface{}{map[string]interface{}{"recon": true}},
+				"files_processes": []interface{}{map[string]interface{}{"run_command": "/usr/bin/true"}},
+			}},
+		},
+		// package_distribution_point is not read back; the payload's packages block carries the distribution point.
+		importIgnore: []string{"package_distribution_point"},
+	},
+	{
+		resourceType: "jamfpro_computer_prestage_enrollment",
+		create:       mockComputerPrestage("Jamf IT"),
+		update:       mockComputerPrestage("Jamf IT Support"),
+	},
+}
+
+// lifecycleSkipped lists the resources deliberately left out of lifecycleCases, with the reason.
+var lifecycleSkipped = map[string]string{
+	"jamfpro_activation_code":                             "singleton setting; delete only removes it from state",
+	"jamfpro_computer_checkin":                            "singleton setting; delete only removes it from state",
+	"jamfpro_computer_inventory_collection":               "singleton setting; delete only removes it from state",
+	"jamfpro_allowed_file_extension":                      "has no updatable attributes, so there is no update step to run",
+	"jamfpro_api_integration_client_credentials":          "issues a secret as an action and cannot be read back or imported",
+	"jamfpro_token_invalidation":                          "invalidates the provider's own token as an action",
+	"jamfpro_computer_prestage_enrollment_scope":          "covered by the version lock tests",
+	"jamfpro_package":                                     "covered by TestPackageLifecycle",
+	"jamfpro_macos_configuration_profile_plist_generator": "reads each payload setting back nested in a dictionary, so the plan after create never settles",
+}
+
+// TestLifecycleCoverage checks that every resource is either exercised by lifecycleCases or listed in lifecycleSkipped.
+func TestLifecycleCoverage(t *testing.T) {
+	covered := make(map[string]bool, len(lifecycleCases))
+	for _, tc := range lifecycleCases {
+		covered[tc.resourceType] = true
+	}
+
+	for name := range Provider().ResourcesMap {
+		_, skipped := lifecycleSkipped[name]
+		switch {
+		case covered[name] && skipped:
+			t.Errorf("%s is both covered and skipped", name)
+		case !covered[name] && !skipped:
+			t.Errorf("%s has no lifecycle case; add one or list it in lifecycleSkipped with the reason", name)
+		}
+	}
+}
+
+// mockProfilePayload returns a configuration profile plist with a single payload holding settings, given as plist
+// keys and values, formatted as the provider requires. Its display name carries an ampersand, which must survive the
+// HTML escaping of the payloads.
+func mockProfilePayload(scope, name, settings string) string {
+	payload, err := datavalidators.FormatPlist(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			` + settings + `
+			<key>PayloadDisplayName</key>
+			<string>` + name + ` &amp; more</string>
+			<key>PayloadIdentifier</key>
+			<string>com.example.` + name + `.payload</string>
+			<key>PayloadType</key>
+			<string>com.apple.` + strings.ToLower(name) + `</string>
+			<key>PayloadUUID</key>
+			<string>5A2B0B1E-0000-4000-8000-000000000002</string>
+			<key>PayloadVersion</key>
+			<integer>1</integer>
+		</dict>
+	</array>
+	<key>PayloadDisplayName</key>
+	<string>` + name + `</string>
+	<key>PayloadEnabled</key>
+	<true/>
+	<key>PayloadIdentifier</key>
+	<string>5A2B0B1E-0000-4000-8000-000000000001</string>
+	<key>PayloadOrganization</key>
+	<string>Example</string>
+	<key>PayloadRemovalDisallowed</key>
+	<true/>
+	<key>PayloadScope</key>
+	<string>` + scope + `</string>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+	<key>PayloadUUID</key>
+	<string>5A2B0B1E-0000-4000-8000-000000000001</string>
+	<key>PayloadVersion</key>
+	<integer>1</integer>
+</dict>
+</plist>`)
+	if err != nil {
+		panic(err)
+	}
+	return payload
+}
+
+// mockComputerPrestage returns a computer prestage configuration with every required attribute set.
+func mockComputerPrestage(department string) map[string]interface{} {
+	return map[string]interface{}{
+		"display_name":                          "tf-mock-computer-prestage",
+		"device_enrollment_program_instance_id": "1",
+		"enrollment_site_id":                    "-1",
+		"authentication_prompt":                 "Welcome",
+		"department":                            department,
+		"support_phone_number":                  "555-0100",
+		"auto_advance_setup":                    false,
+		"default_prestage":                      false,
+		"enable_device_based_activation_lock":   false,
+		"install_profiles_during_setup":         true,
+		"keep_existing_location_information":    false,
+		"keep_existing_site_membership":         false,
+		"mandatory":                             true,
+		"mdm_removable":                         false,
+		"prevent_activation_lock":               true,
+		"skip_setup_items":                      []interface{}{map[string]interface{}{"location": true, "siri": true}},
+		"location_information": []interface{}{map[string]interface{}{
+			"id": "-1", "version_lock": 0, "username": "", "realname": "", "phone": "", "email": "", "room": "", "position": "",
+		}},
+		"purchasing_information": []interface{}{map[string]interface{}{
+			"id": "-1", "version_lock": 0, "leased": false, "purchased": true, "apple_care_id": "", "po_number": "",
+			"vendor": "", "purchase_price": "", "life_expectancy": 0, "purchasing_account": "", "purchasing_contact": "",
+			"lease_date": "1970-01-01", "po_date": "1970-01-01", "warranty_date": "1970-01-01",
+		}},
+	}
+}
+
+// TestResourceLifecycles creates, reads, imports, updates and deletes each resource of lifecycleCases against the
+// mock Jamf Pro without Terraform itself, driving the resources through the same plan and apply steps Terraform uses.
+// After every apply the configuration must plan no further changes.
+func TestResourceLifecycles(t *testing.T) {
+	for _, tc := range lifecycleCases {
+		tc := tc
+		t.Run(tc.resourceType, func(t *testing.T) {
+			provider, _ := configureMockProvider(t)
+			runLifecycle(t, provider, tc)
+		})
+	}
+}
+
+// runLifecycle runs the steps of a lifecycleCase against a configured provider.
+func runLifecycle(t *testing.T, provider *schema.Provider, tc lifecycleCase) {
+	t.Helper()
+
+	ctx := context.Background()
+	meta := provider.Meta()
+
+	r, ok := provider.ResourcesMap[tc.resourceType]
+	if !ok {
+		t.Fatalf("unknown resource %s", tc.resourceType)
+	}
+
+	state := applyLifecycleStep(ctx, t, r, nil, tc.create, meta, "create")
+	if state.ID == "" {
+		t.Fatal("create returned no ID")
+	}
+
+	if r.Importer != nil {
+		verifyImport(ctx, t, r, state, tc.importIgnore, meta)
+	}
+
+	updated := applyLifecycleStep(ctx, t, r, state, tc.update, meta, "update")
+	if r.Importer != nil {
+		verifyImport(ctx, t, r, updated, tc.importIgnore, meta)
+	}
+
+	if _, diags := r.Apply(ctx, updated, &terraform.InstanceDiff{Destroy: true}, meta); diags.HasError() {
+		t.Fatalf("delete failed: %v", diags)
+	}
+
+	refreshed, diags := r.RefreshWithoutUpgrade(ctx, updated, meta)
+	if diags.HasError() {
+		t.Fatalf("read after delete failed: %v", diags)
+	}
+	if refreshed != nil && refreshed.ID != "" {
+		t.Fatalf("resource %s still exists after delete", refreshed.ID)
+	}
+}
+
+// applyLifecycleStep plans and applies a configuration, then checks that refreshing and planning it again shows
+// no changes, as Terraform does after every apply in an acceptance test.
+func applyLifecycleStep(ctx context.Context, t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}, step string) *terraform.InstanceState {
+	t.Helper()
+
+	config := terraform.NewResourceConfigRaw(raw)
+
+	diff, err := r.Diff(ctx, state, config, meta)
+	if err != nil {
+		t.Fatalf("%s: plan failed: %v", step, err)
+	}
+
+	newState, diags := r.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("%s: apply failed: %v", step, diags)
+	}
+
+	refreshed, diags := r.RefreshWithoutUpgrade(ctx, newState, meta)
+	if diags.HasError() {
+		t.Fatalf("%s: read after apply failed: %v", step, diags)
+	}
+	if refreshed == nil || refreshed.ID == "" {
+		t.Fatalf("%s: resource disappeared after apply", step)
+	}
+
+	planned, err := r.Diff(ctx, refreshed, config, meta)
+	if err != nil {
+		t.Fatalf("%s: plan after apply failed: %v", step, err)
+	}
+	if planned != nil && !planned.Empty() {
+		t.Fatalf("%s: plan after apply is not empty:\n%s", step, describeDiff(planned))
+	}
+
+	return refreshed
+}
+
+// verifyImport imports the resource by its ID and checks that the imported state matches the applied one, as
+// ImportStateVerify does in an acceptance test.
+func verifyImport(ctx context.Context, t *testing.T, r *schema.Resource, state *terraform.InstanceState, ignore []string, meta interface{}) {
+	t.Helper()
+
+	data := r.Data(&terraform.InstanceState{ID: state.ID})
+
+	var imported []*schema.ResourceData
+	var err error
+	switch {
+	case r.Importer.StateContext != nil:
+		imported, err = r.Importer.StateContext(ctx, data, meta)
+	default:
+		imported, err = r.Importer.State(data, meta)
+	}
+	if err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("import returned %d resources, expected 1", len(imported))
+	}
+
+	importedState, diags := r.RefreshWithoutUpgrade(ctx, imported[0].State(), meta)
+	if diags.HasError() {
+		t.Fatalf("read after import failed: %v", diags)
+	}
+	if importedState == nil {
+		t.Fatal("import found no resource")
+	}
+
+	skip := map[string]bool{"%": true, "id": true}
+	for _, key := range ignore {
+		skip[key] = true
+	}
+
+	for key, want := range state.Attributes {
+		if skip[key] || isTimeoutsAttribute(key) {
+			continue
+		}
+		if got := importedState.Attributes[key]; got != want {
+			t.Errorf("import: attribute %s is %q, expected %q", key, got, want)
+		}
+	}
+}
+
+// isTimeoutsAttribute reports whether a flatmap key belongs to the timeouts block, which is never imported.
+func isTimeoutsAttribute(key string) bool {
+	return len(key) >= 8 && key[:8] == "timeouts"
+}
+
+// describeDiff formats the attributes of a diff for test failure messages.
+func describeDiff(diff *terraform.InstanceDiff) string {
+	keys := make([]string, 0, len(diff.Attributes))
+	for key := range diff.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var out string
+	for _, key := range keys {
+		attribute := diff.Attributes[key]
+		out += fmt.Sprintf("  %s: %q => %q\n", key, attribute.Old, attribute.New)
+	}
+	return out
+}
+
+// TestPackageLifecycle runs the lifecycle of a package, replacing its file in the update so the upload is repeated.
+func TestPackageLifecycle(t *testing.T) {
+	dir := t.TempDir()
+
+	firstFile := filepath.Join(dir, "tf-mock-package.pkg")
+	if err := os.WriteFile(firstFile, []byte("first build"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	secondFile := filepath.Join(dir, "tf-mock-package-2.pkg")
+	if err := os.WriteFile(secondFile, []byte("second build"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	packageConfig := func(source string) map[string]interface{} {
+		return map[string]interface{}{
+			"package_name":          "tf-mock-package",
+			"package_file_source":   source,
+			"priority":              10,
+			"fill_user_template":    false,
+			"reboot_required":       false,
+			"os_install":            false,
+			"suppress_updates":      false,
+			"suppress_from_dock":    false,
+			"suppress_eula":         false,
+			"suppress_registration": false,
+		}
+	}
+
+	provider, server := configureMockProvider(t)
+	runLifecycle(t, provider, lifecycleCase{
+		resourceType: "jamfpro_package",
+		create:       packageConfig(firstFile),
+		update:       packageConfig(secondFile),
+		importIgnore: []string{"package_file_source", "md5_file_hash", "sha256_file_hash", "reconciliation_mode", "download_max_size_bytes", "download_cache_max_size_mb"},
+	})
+
+	if uploads := server.RequestCount("POST /api/v1/packages/1/upload"); uploads != 2 {
+		t.Fatalf("expected the package file to be uploaded twice, got %d uploads", uploads)
+	}
+}
+
+// TestPackageUploadFailureKeepsID fails the upload of a new package, which must leave the created package in state,
+// so Terraform taints it rather than losing track of it, and must not create the package again.
+func TestPackageUploadFailureKeepsID(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "tf-mock-package.pkg")
+	if err := os.WriteFile(file, []byte("first build"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := mockjamfpro.New()
+	provider := newProvider(func(*http.Transport) httpclient.HTTPExecutor {
+		return &failingUploadExecutor{HTTPExecutor: server.Executor()}
+	}, newTokenCache())
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(mockProviderConfig(server))); diags.HasError() {
+		t.Fatalf("failed to configure provider against mock Jamf Pro: %v", diags)
+	}
+
+	r := provider.ResourcesMap["jamfpro_package"]
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"package_name":        "tf-mock-package",
+		"package_file_source": file,
+		"priority":            10,
+	}), provider.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, diags := r.Apply(ctx, nil, diff, provider.Meta())
+	if !diags.HasError() {
+		t.Fatal("expected the create to fail with the upload")
+	}
+	if state == nil || state.ID != "1" {
+		t.Fatalf("expected the created package to be kept in state, got %v", state)
+	}
+	if creates := server.RequestCount("POST /api/v1/packages"); creates != 1 {
+		t.Fatalf("expected the package to be created once, got %d creates", creates)
+	}
+}
+
+// failingUploadExecutor fails every package upload with a server error.
+type failingUploadExecutor struct {
+	httpclient.HTTPExecutor
+}
+
+func (e *failingUploadExecutor) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/upload") {
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	}
+	return e.HTTPExecutor.Do(req)
+}
+
+// TestPackageDataSourceByName looks a package up by name, which must list the packages only once, and looks up a
+// name matching no package, which must fail as not found.
+func TestPackageDataSourceByName(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "tf-mock-lookup.pkg")
+	if err := os.WriteFile(source, []byte("lookup build"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	provider, server := configureMockProvider(t)
+	created := applyLifecycleStep(ctx, t, provider.ResourcesMap["jamfpro_package"], nil, map[string]interface{}{
+		"package_name":        "tf-mock-lookup",
+		"package_file_source": source,
+		"priority":            10,
+	}, provider.Meta(), "create")
+
+	dataSource := provider.DataSourcesMap["jamfpro_package"]
+	lists := server.RequestCount("GET /api/v1/packages")
+
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": "tf-mock-lookup"})
+	if diags := dataSource.ReadContext(ctx, d, provider.Meta()); diags.HasError() {
+		t.Fatalf("lookup by name failed: %v", diags)
+	}
+	if d.Id() != created.ID {
+		t.Fatalf("expected package %s, got %s", created.ID, d.Id())
+	}
+	if got := server.RequestCount("GET /api/v1/packages") - lists; got != 1 {
+		t.Fatalf("expected the packages to be listed once, got %d lists", got)
+	}
+
+	missing := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": "tf-mock-missing"})
+	diags := dataSource.ReadContext(ctx, missing, provider.Meta())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "no Jamf Pro Package found") {
+		t.Fatalf("expected a not found error, got %v", diags)
+	}
+}
+
+// TestPackageDownloadCache creates packages from URLs served with a certificate trusted through ca_bundle_pem. Two
+// packages sharing a URL and checksum, created in parallel, must download it once, and the least recently used
+// entries must be evicted once the cache exceeds download_cache_max_size_mb.
+func TestPackageDownloadCache(t *testing.T) {
+	shared := []byte("shared build")
+	large := bytes.Repeat([]byte("x"), 768<<10)
+
+	var mu sync.Mutex
+	downloads := make(map[string]int)
+	files := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		downloads[r.URL.Path]++
+		mu.Unlock()
+
+		if r.URL.Path == "/shared.pkg" {
+			w.Write(shared)
+			return
+		}
+		w.Write(large)
+	}))
+	defer files.Close()
+
+	server := mockjamfpro.New()
+	provider := newMockProvider(server)
+	config := mockProviderConfig(server)
+	config["ca_bundle_pem"] = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: files.Certificate().Raw}))
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+
+	ctx := context.Background()
+	r := provider.ResourcesMap["jamfpro_package"]
+	cacheDir := t.TempDir()
+	sharedSum := sha256.Sum256(shared)
+
+	create := func(name string, raw map[string]interface{}) error {
+		raw["package_name"] = name
+		raw["priority"] = 10
+		raw["download_cache_directory"] = cacheDir
+
+		diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), provider.Meta())
+		if err != nil {
+			return err
+		}
+		if _, diags := r.Apply(ctx, nil, diff, provider.Meta()); diags.HasError() {
+			return fmt.Errorf("%s: %v", name, diags)
+		}
+		return nil
+	}
+
+	errs := make(chan error, 2)
+	for _, name := range []string{"tf-mock-shared-1", "tf-mock-shared-2"} {
+		go func(name string) {
+			errs <- create(name, map[string]interface{}{
+				"package_file_source": files.URL + "/shared.pkg",
+				"checksum":            "sha256:" + hex.EncodeToString(sharedSum[:]),
+			})
+		}(name)
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	mu.Lock()
+	sharedDownloads := downloads["/shared.pkg"]
+	mu.Unlock()
+	if sharedDownloads != 1 {
+		t.Fatalf("expected the shared URL to be downloaded once, got %d downloads", sharedDownloads)
+	}
+
+	for _, name := range []string{"large-a", "large-b"} {
+		if err := create("tf-mock-"+name, map[string]interface{}{
+			"package_file_source":        files.URL + "/" + name + ".pkg",
+			"download_cache_max_size_mb": 1,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		// Modification times order the entries, so keep them apart on file systems with a coarse resolution.
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected only the most recent download to remain cached, found %d entries", len(entries))
+	}
+}
+
+// unknownConfigValue is how a raw resource configuration marks a value only known on apply.
+const unknownConfigValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// TestPackageDownloadCancelled starts a download which the source never finishes, then creates a second package from
+// the same URL while the first holds the cache entry. Both must give up once their context is done.
+func TestPackageDownloadCancelled(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			return
+		}
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer files.Close()
+	defer close(release)
+
+	provider, _ := configureMockProvider(t)
+	r := provider.ResourcesMap["jamfpro_package"]
+	cacheDir := t.TempDir()
+
+	create := func(ctx context.Context, name string) error {
+		raw := map[string]interface{}{
+			"package_name":             name,
+			"package_file_source":      files.URL + "/slow.pkg",
+			"priority":                 10,
+			"download_cache_directory": cacheDir,
+		}
+		diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), provider.Meta())
+		if err != nil {
+			return err
+		}
+		if _, diags := r.Apply(ctx, nil, diff, provider.Meta()); diags.HasError() {
+			return fmt.Errorf("%s: %v", name, diags)
+		}
+		return nil
+	}
+
+	downloadCtx, cancelDownload := context.WithCancel(context.Background())
+	downloadErr := make(chan error, 1)
+	go func() { downloadErr <- create(downloadCtx, "tf-mock-downloading") }()
+	<-started
+
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancelWait()
+	waitErr := make(chan error, 1)
+	go func() { waitErr <- create(waitCtx, "tf-mock-waiting") }()
+	select {
+	case err := <-waitErr:
+		if err == nil {
+			t.Fatal("expected the package waiting for the cache entry to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected waiting for the locked cache entry to stop with its context")
+	}
+
+	cancelDownload()
+	select {
+	case err := <-downloadErr:
+		if err == nil {
+			t.Fatal("expected the cancelled download to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the download to stop with its context")
+	}
+}
+
+// TestPackagePlanWithHangingSource plans a package whose source never answers the HEAD request for its hashes.
+// The plan must stop waiting once its context is done.
+func TestPackagePlanWithHangingSource(t *testing.T) {
+	release := make(chan struct{})
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer files.Close()
+	defer close(release)
+
+	provider, _ := configureMockProvider(t)
+	r := provider.ResourcesMap["jamfpro_package"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"package_name":        "tf-mock-package",
+		"package_file_source": files.URL + "/hanging.pkg",
+		"priority":            10,
+	})
+	state := &terraform.InstanceState{ID: "1", Attributes: map[string]string{
+		"id":                  "1",
+		"package_name":        "tf-mock-package",
+		"package_file_source": files.URL + "/old.pkg",
+		"md5_file_hash":       "0123456789abcdef0123456789abcdef",
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	planned := make(chan struct{})
+	go func() {
+		r.Diff(ctx, state, config, provider.Meta())
+		close(planned)
+	}()
+	select {
+	case <-planned:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the plan to stop waiting for the source with its context")
+	}
+}
+
+// TestPackagePlanWithUnavailableSource plans a package whose package_file_source is unknown or not yet on disk,
+// which must leave the file attributes unknown rather than fail the plan.
+func TestPackagePlanWithUnavailableSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "tf-mock-plan.pkg")
+	if err := os.WriteFile(source, []byte("plan build"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	provider, _ := configureMockProvider(t)
+	r := provider.ResourcesMap["jamfpro_package"]
+	config := func(source string) map[string]interface{} {
+		return map[string]interface{}{"package_name": "tf-mock-plan", "package_file_source": source, "priority": 10}
+	}
+	state := applyLifecycleStep(ctx, t, r, nil, config(source), provider.Meta(), "create")
+
+	for name, source := range map[string]string{
+		"unknown": unknownConfigValue,
+		"missing": filepath.Join(t.TempDir(), "tf-mock-plan-2.pkg"),
+	} {
+		diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config(source)), provider.Meta())
+		if err != nil {
+			t.Fatalf("%s source: plan failed: %v", name, err)
+		}
+		if attr := diff.Attributes["md5_file_hash"]; attr == nil || !attr.NewComputed {
+			t.Fatalf("%s source: expected md5_file_hash to be planned as unknown, got %s", name, describeDiff(diff))
+		}
+	}
+}
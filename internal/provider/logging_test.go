@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProviderAliasesLogWithTheirOwnSettings(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_JAMFPRO_CRUD", "DEBUG")
+	ctx := context.Background()
+	dir := t.TempDir()
+	server := mockjamfpro.New()
+
+	// Both providers are configured before either creates a category, so logs written with the settings of the
+	// provider configured last would all land in the export of the second.
+	aliases := []string{"first", "second"}
+	exports := map[string]string{}
+	providers := map[string]*schema.Provider{}
+	for _, alias := range aliases {
+		exports[alias] = filepath.Join(dir, alias+".log")
+		config := mockProviderConfig(server)
+		config["client_sdk_log_export_path"] = exports[alias]
+
+		providers[alias] = newMockProvider(server)
+		if diags := providers[alias].Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+			t.Fatalf("failed to configure provider %s: %v", alias, diags)
+		}
+	}
+	for _, alias := range aliases {
+		category := map[string]interface{}{"name": "tf-mock-category-" + alias, "priority": 9}
+		applyLifecycleStep(ctx, t, providers[alias].ResourcesMap["jamfpro_category"], nil, category, providers[alias].Meta(), "create")
+	}
+
+	for alias, path := range exports {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, other := range aliases {
+			logged := strings.Contains(string(content), "tf-mock-category-"+other)
+			if logged != (other == alias) {
+				t.Errorf("expected the export of provider %s to hold only its own category, holding the category of %s is %t", alias, other, logged)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPatchPolicyPlanValidation(t *testing.T) {
+	ctx := context.Background()
+	provider, _ := configureMockProvider(t)
+	r := provider.ResourcesMap["jamfpro_patch_policy"]
+
+	for name, tc := range map[string]struct {
+		overrides map[string]interface{}
+		want      string
+	}{
+		"user scope": {
+			overrides: map[string]interface{}{"scope": []interface{}{map[string]interface{}{"all_computers": false, "jss_user_ids": []interface{}{1}}}},
+			want:      "'scope.0.jss_user_ids' cannot be set",
+		},
+		"self service without block": {
+			overrides: map[string]interface{}{"distribution_method": "selfservice"},
+			want:      "'self_service' block is required",
+		},
+		"self service block when prompted": {
+			overrides: map[string]interface{}{"self_service": []interface{}{map[string]interface{}{"description": "Updates Google Chrome."}}},
+			want:      "'self_service' block is not allowed",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"name":                            "tf-mock-patch-policy",
+				"enabled":                         true,
+				"software_title_configuration_id": "1",
+				"target_version":                  "124.0.6367.60",
+				"scope":                           []interface{}{map[string]interface{}{"all_computers": true}},
+			}
+			for key, value := range tc.overrides {
+				config[key] = value
+			}
+
+			_, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(config), provider.Meta())
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected the plan to fail with %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
@@ -5,12 +5,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/accountgroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/accounts"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/activationcode"
@@ -18,15 +18,21 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/advancedmobiledevicesearches"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/advancedusersearches"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/allowedfileextensions"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/apiintegrationclientcredentials"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/apiintegrations"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/apiroles"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/buildings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/categories"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/externaltransport"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfversion"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/tokencache"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computercheckin"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerextensionattributes"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computergroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerinventory"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerinventorycollection"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerprestageenrollments"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerprestageenrollmentscopes"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/departments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/diskencryptionconfigurations"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/dockitems"
@@ -34,21 +40,28 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/macosconfigurationprofilesplist"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/macosconfigurationprofilesplistgenerator"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/mobiledeviceconfigurationprofilesplist"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/mobiledeviceextensionattributes"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/mobiledeviceprestageenrollments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/networksegments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/packages"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/patchpolicies"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/patchsoftwaretitleconfigurations"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/policies"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/printers"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/restrictedsoftware"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/scripts"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/serverinfo"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/sites"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/smartcomputergroups"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/smartmobiledevicegroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/staticcomputergroups"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/staticmobiledevicegroups"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/tokeninvalidation"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/usergroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/webhooks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"go.uber.org/zap"
 )
 
 // TerraformProviderProductUserAgent is included in the User-Agent header for
@@ -64,193 +77,36 @@ const (
 	jamfLoadBalancerCookieName        = "jpro-ingress"
 )
 
-/*
-GetJamfFqdn retrieves the instance domain name from the provided schema resource data.
-
-If the instance domain is not found, it appends an error diagnostic to the diagnostics slice.
-
-Parameters:
-
-	d      - A pointer to the schema.ResourceData object which contains the resource data.
-	diags  - A pointer to a slice of diag.Diagnostics where error messages will be appended.
-
-Returns:
-
-	A string representing the instance domain name. If the instance domain name is not provided,
-	an error diagnostic is appended to diags and an empty string is returned.
-*/
-func GetJamfFqdn(d *schema.ResourceData, diags *diag.Diagnostics) string {
-	jamf_fqdn, ok := d.GetOk("jamfpro_instance_fqdn")
-	if jamf_fqdn.(string) == "" || !ok {
-		*diags = append(*diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Error getting instance name",
-			Detail:   "instance_name must be provided either as an environment variable (JAMFPRO_INSTANCE_FQDN) or in the Terraform configuration",
-		})
-		return ""
-	}
-	return jamf_fqdn.(string)
-}
-
-/*
-GetClientID retrieves the client ID from the provided schema resource data.
-If the client ID is not found, it appends an error diagnostic to the diagnostics slice.
-
-Parameters:
-
-	d      - A pointer to the schema.ResourceData object which contains the resource data.
-	diags  - A pointer to a slice of diag.Diagnostics where error messages will be appended.
-
-Returns:
-
-	A string representing the client ID. If the client ID is not provided,
-	an error diagnostic is appended to diags and an empty string is returned.
-*/
-func GetClientID(d *schema.ResourceData, diags *diag.Diagnostics) string {
-	clientID := d.Get("client_id").(string)
-	if clientID == "" {
-
-		*diags = append(*diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Error getting client id",
-			Detail:   "client_id must be provided either as an environment variable (JAMFPRO_CLIENT_ID) or in the Terraform configuration",
-		})
-
-		return ""
-
-	}
-	return clientID
-}
-
-/*
-GetClientSecret retrieves the client secret from the provided schema resource data.
-If the client ID is not found, it appends an error diagnostic to the diagnostics slice.
-
-Parameters:
-
-	d      - A pointer to the schema.ResourceData object which contains the resource data.
-	diags  - A pointer to a slice of diag.Diagnostics where error messages will be appended.
-
-Returns:
-
-	A string representing the client ID. If the client ID is not provided,
-	an error diagnostic is appended to diags and an empty string is returned.
-*/
-func GetClientSecret(d *schema.ResourceData, diags *diag.Diagnostics) string {
-	clientSecret := d.Get("client_secret").(string)
-	if clientSecret == "" {
-
-		*diags = append(*diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Error getting client secret",
-			Detail:   "client_secret must be provided either as an environment variable (JAMFPRO_CLIENT_SECRET) or in the Terraform configuration",
-		})
-
-		return ""
-
-	}
-	return clientSecret
-}
-
-/*
-GetBasicAuthUsername retrieves the basic auth username from the provided schema resource data.
-If the client ID is not found, it appends an error diagnostic to the diagnostics slice.
-
-Parameters:
-
-	d      - A pointer to the schema.ResourceData object which contains the resource data.
-	diags  - A pointer to a slice of diag.Diagnostics where error messages will be appended.
-
-Returns:
-
-	A string representing the client ID. If the client ID is not provided,
-	an error diagnostic is appended to diags and an empty string is returned.
-*/
-func GetBasicAuthUsername(d *schema.ResourceData, diags *diag.Diagnostics) string {
-	username, ok := d.GetOk("basic_auth_username")
-	if !ok || username.(string) == "" {
-		*diags = append(*diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Error getting basic auth username",
-			Detail:   "basic_auth_username must be provided either as an environment variable (JAMFPRO_BASIC_USERNAME) or in the Terraform configuration",
-		})
-		return ""
-	}
-	return username.(string)
-}
-
-/*
-GetBasicAuthPassword retrieves the basic auth password from the provided schema resource data.
-If the client ID is not found, it appends an error diagnostic to the diagnostics slice.
-
-Parameters:
-
-	d      - A pointer to the schema.ResourceData object which contains the resource data.
-	diags  - A pointer to a slice of diag.Diagnostics where error messages will be appended.
-
-Returns:
-
-	A string representing the client ID. If the client ID is not provided,
-	an error diagnostic is appended to diags and an empty string is returned.
-*/
-func GetBasicAuthPassword(d *schema.ResourceData, diags *diag.Diagnostics) string {
-	password, ok := d.GetOk("basic_auth_password")
-	if !ok || password.(string) == "" {
-		*diags = append(*diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Error getting basic auth password",
-			Detail:   "basic_auth_password must be provided either as an environment variable (JAMFPRO_BASIC_PASSWORD) or in the Terraform configuration",
-		})
-		return ""
-	}
-	return password.(string)
+// Schema defines the configuration attributes for the  within the JamfPro provider.
+func Provider() *schema.Provider {
+	return newProvider(newProdExecutor, sharedTokenCache)
 }
 
-/*
-GetAuthMethod retrieves the auth method from the provided schema resource data.
-If the auth method is not found, it appends an error diagnostic to the diagnostics slice.
-
-Parameters:
-
-	d      - A pointer to the schema.ResourceData object which contains the resource data.
-	diags  - A pointer to a slice of diag.Diagnostics where error messages will be appended.
-
-Returns:
-
-	A string representing the auth method. If the auth method is not provided,
-	an error diagnostic is appended to diags and an empty string is returned.
-*/
-func GetAuthMethod(d *schema.ResourceData, diags *diag.Diagnostics) string {
-	authMethod, ok := d.GetOk("auth_method")
-	if !ok || authMethod.(string) == "" {
-		*diags = append(*diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Error getting auth method",
-			Detail:   "auth_method must be provided either as an environment variable (JAMFPRO_AUTH_METHOD) or in the Terraform configuration",
-		})
-		return ""
-	}
-	return authMethod.(string)
+// newProdExecutor returns an HTTP executor sending requests to Jamf Pro over the network through transport.
+func newProdExecutor(transport *http.Transport) httpclient.HTTPExecutor {
+	return &httpclient.ProdExecutor{Client: &http.Client{Transport: transport}}
 }
 
-// Schema defines the configuration attributes for the  within the JamfPro provider.
-func Provider() *schema.Provider {
+// newProvider builds the provider with HTTP executors obtained from newExecutor, one for authentication and one for
+// the API client, both given the transport built from the network settings. Access tokens are shared through tokens.
+// Tests pass the executor of an in-memory Jamf Pro and a cache of their own here.
+func newProvider(newExecutor func(transport *http.Transport) httpclient.HTTPExecutor, tokens *tokenCache) *schema.Provider {
 
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"jamfpro_instance_fqdn": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(envVarJamfProFQDN, ""),
-				Description: "The Jamf Pro FQDN (fully qualified domain name). example: https://mycompany.jamfcloud.com",
+				Description: "The Jamf Pro FQDN (fully qualified domain name). example: https://mycompany.jamfcloud.com. Required unless set in the selected profile of config_file.",
 			},
 			"auth_method": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(envVarJamfProAuthMethod, ""),
-				Description: "Auth method chosen for Jamf.",
+				Description: "Auth method chosen for Jamf. Required unless set in the selected profile of config_file.",
 				ValidateFunc: validation.StringInSlice([]string{
-					"basic", "oauth2",
+					"basic", "oauth2", "",
 				}, true),
 			},
 			"client_id": {
@@ -266,6 +122,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(envVarOAuthClientSecret, ""),
 				Description: "The Jamf Pro Client secret for authentication when auth_method is 'oauth2'.",
 			},
+			"client_secret_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarOAuthClientSecretFile, ""),
+				Description: "The path of a file holding the Jamf Pro Client secret, e.g. one rendered by a Vault agent. Read when client_secret is not set alongside it, and takes precedence over a client secret of the environment or profile.",
+			},
 			"basic_auth_username": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -279,17 +141,56 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(envVarBasicAuthPassword, ""),
 				Description: "The Jamf Pro password used for authentication when auth_method is 'basic'.",
 			},
+			"basic_auth_password_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarBasicAuthPasswordFile, ""),
+				Description: "The path of a file holding the Jamf Pro password. Read when basic_auth_password is not set alongside it, and takes precedence over a password of the environment or profile.",
+			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarCredentialProcess, ""),
+				Description: "A command run through the shell which prints the credentials missing from the other settings to stdout, as JSON of the form " + credentialProcessOutputFormat + ". BasicAuthUsername and BasicAuthPassword are read for basic auth.",
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarConfigFile, ""),
+				Description: "The path of an INI file of named profiles holding any of jamfpro_instance_fqdn, auth_method, the credential settings, their *_file variants and credential_process. Settings of the provider block take precedence over the profile.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarProfile, defaultProfileName),
+				Description: "The profile of config_file to use.",
+			},
 			"enable_client_sdk_logs": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
+				Deprecated:  "Logs of the SDK and HTTP client are always passed to Terraform. Set TF_LOG_PROVIDER or TF_LOG_PROVIDER_JAMFPRO_HTTP to see them.",
 				Description: "Debug option to propogate logs from the SDK and HttpClient",
 			},
 			"client_sdk_log_export_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "",
-				Description: "Specify the path to export http client logs to.",
+				Description: "Specify the path of a file to export the provider logs to as JSON lines, at the levels set through TF_LOG_PROVIDER and its subsystem variables. The file is created if needed and rotated.",
+			},
+			"log_export_max_size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The size in megabytes at which the log export file is rotated.",
+			},
+			"log_export_max_backups": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of rotated log export files kept, named after the file with the suffixes .1, .2 and so on.",
 			},
 			"hide_sensitive_data": {
 				Type:        schema.TypeBool,
@@ -321,7 +222,44 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Programatically determines all available web app members in the load balance and locks all instances of httpclient to the app for faster executions. \nTEMP SOLUTION UNTIL JAMF PROVIDES SOLUTION",
+				Description: "Discovers the web app members behind the Jamf Cloud load balancer and pins every request, including authentication, to one of them so that changes are visible to the requests which follow. Should the member go away during a run, requests are pinned to another member.",
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarProxyURL, ""),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description:  "The URL of the proxy to send requests to Jamf Pro and package downloads through, e.g. http://proxy.example.com:3128. The HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used if not set.",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarCABundleFile, ""),
+				Description: "The path of a PEM file of certificate authorities to trust besides those of the system, for Jamf Pro and package downloads, e.g. that of a TLS inspecting proxy.",
+			},
+			"ca_bundle_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded certificate authorities to trust besides those of the system. May be combined with ca_bundle_file.",
+			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key"},
+				Description:  "The PEM encoded client certificate, or the path of a PEM file, presented to Jamf Pro for mutual TLS.",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_certificate"},
+				Description:  "The PEM encoded private key of client_certificate, or the path of a PEM file.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the verification of the certificate of Jamf Pro. Anyone on the network path can then read and alter requests, credentials included. Only for testing.",
 			},
 			"token_refresh_buffer_period_seconds": {
 				Type:        schema.TypeInt,
@@ -330,44 +268,161 @@ func Provider() *schema.Provider {
 				Description: "The buffer period in seconds for token refresh.",
 			},
 
+			"token_cache_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: defaultTokenCacheDir,
+				Description: "The directory access tokens are cached in, so aliases and later runs configured for the same Jamf Pro and client reuse them while they remain valid. Defaults to JAMFPRO_TOKEN_CACHE_DIR, else terraform-provider-jamfpro/tokens within the user's cache directory. The directory holds live credentials and is created readable by the user running Terraform only. Set to \"\" to keep tokens in memory, unshared.",
+			},
 			"mandatory_request_delay_milliseconds": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     100,
 				Description: "A mandatory delay after each request before returning to reduce high volume of requests in a short time",
 			},
+			"validate_on_configure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail configuring the provider if the Jamf Pro version cannot be read, i.e. Jamf Pro cannot be reached or rejects the credentials.",
+			},
+			"validate_privileges_for": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Resource types, e.g. \"jamfpro_policy\", whose privileges are compared with those granted to the API client through its API roles when configuring the provider with OAuth, warning of any missing.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(privilegedResources(), false),
+				},
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarReadOnly, false),
+				Description: "Refuse to create, update or delete anything in Jamf Pro. Resources are still read and planned and data sources work as usual, so drift can be detected with production credentials without any risk of a change.",
+			},
+			"default_timeouts": defaultTimeoutsSchema(),
+			"max_retry_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of times a request failing with a retryable error is repeated. 423, 429 and 503 responses are retried for every request, 408, 500, 502 and 504 responses and network errors only for idempotent requests, never for POST or PATCH. Resources do not repeat a request once its retries are spent. Set to 0 to disable retries.",
+			},
+			"retry_base_delay_milliseconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The backoff before the first retry of a request, doubled for each further retry up to retry_max_delay_milliseconds. A longer Retry-After header sent by Jamf Pro takes precedence.",
+			},
+			"retry_max_delay_milliseconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30000,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The upper bound of the backoff between two attempts of a request.",
+			},
+			"retry_jitter": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Randomise each backoff between half and all of its length, so requests failing together do not retry together.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of requests in flight to Jamf Pro at any time. 0 leaves concurrency to the Terraform parallelism.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The sustained rate of requests sent to Jamf Pro, enforced by a token bucket. Retries count towards the rate. 0 disables rate limiting.",
+			},
+			"request_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of requests which may be sent at once above requests_per_second, i.e. the size of the token bucket.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 
-			"jamfpro_account":                                   accounts.DataSourceJamfProAccounts(),
-			"jamfpro_account_group":                             accountgroups.DataSourceJamfProAccountGroups(),
-			"jamfpro_advanced_computer_search":                  advancedcomputersearches.DataSourceJamfProAdvancedComputerSearches(),
-			"jamfpro_advanced_mobile_device_search":             advancedmobiledevicesearches.DataSourceJamfProAdvancedMobileDeviceSearches(),
-			"jamfpro_advanced_user_search":                      advancedusersearches.DataSourceJamfProAdvancedUserSearches(),
-			"jamfpro_api_integration":                           apiintegrations.DataSourceJamfProApiIntegrations(),
-			"jamfpro_api_role":                                  apiroles.DataSourceJamfProAPIRoles(),
-			"jamfpro_building":                                  buildings.DataSourceJamfProBuildings(),
-			"jamfpro_category":                                  categories.DataSourceJamfProCategories(),
-			"jamfpro_computer_extension_attribute":              computerextensionattributes.DataSourceJamfProComputerExtensionAttributes(),
-			"jamfpro_computer_inventory":                        computerinventory.DataSourceJamfProComputerInventory(),
-			"jamfpro_computer_prestage_enrollment":              computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentEnrollment(),
-			"jamfpro_department":                                departments.DataSourceJamfProDepartments(),
-			"jamfpro_disk_encryption_configuration":             diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurations(),
-			"jamfpro_dock_item":                                 dockitems.DataSourceJamfProDockItems(),
-			"jamfpro_file_share_distribution_point":             filesharedistributionpoints.DataSourceJamfProFileShareDistributionPoints(),
-			"jamfpro_network_segment":                           networksegments.DataSourceJamfProNetworkSegments(),
-			"jamfpro_macos_configuration_profile_plist":         macosconfigurationprofilesplist.DataSourceJamfProMacOSConfigurationProfilesPlist(),
-			"jamfpro_mobile_device_configuration_profile_plist": mobiledeviceconfigurationprofilesplist.DataSourceJamfProMobileDeviceConfigurationProfilesPlist(),
-			"jamfpro_package":                                   packages.DataSourceJamfProPackages(),
-			"jamfpro_policy":                                    policies.DataSourceJamfProPolicies(),
-			"jamfpro_printer":                                   printers.DataSourceJamfProPrinters(),
-			"jamfpro_script":                                    scripts.DataSourceJamfProScripts(),
-			"jamfpro_site":                                      sites.DataSourceJamfProSites(),
-			"jamfpro_smart_computer_group":                      smartcomputergroups.DataSourceJamfProSmartComputerGroups(),
-			"jamfpro_static_computer_group":                     staticcomputergroups.DataSourceJamfProStaticComputerGroups(),
-			"jamfpro_restricted_software":                       restrictedsoftware.DataSourceJamfProRestrictedSoftwares(),
-			"jamfpro_user_group":                                usergroups.DataSourceJamfProUserGroups(),
-			"jamfpro_webhook":                                   webhooks.DataSourceJamfProWebhooks(),
+			"jamfpro_account":                                    accounts.DataSourceJamfProAccounts(),
+			"jamfpro_accounts":                                   accounts.DataSourceJamfProAccountsList(),
+			"jamfpro_account_group":                              accountgroups.DataSourceJamfProAccountGroups(),
+			"jamfpro_account_groups":                             accountgroups.DataSourceJamfProAccountGroupsList(),
+			"jamfpro_allowed_file_extensions":                    allowedfileextensions.DataSourceJamfProAllowedFileExtensionsList(),
+			"jamfpro_advanced_computer_search":                   advancedcomputersearches.DataSourceJamfProAdvancedComputerSearches(),
+			"jamfpro_advanced_computer_searches":                 advancedcomputersearches.DataSourceJamfProAdvancedComputerSearchesList(),
+			"jamfpro_advanced_mobile_device_search":              advancedmobiledevicesearches.DataSourceJamfProAdvancedMobileDeviceSearches(),
+			"jamfpro_advanced_mobile_device_searches":            advancedmobiledevicesearches.DataSourceJamfProAdvancedMobileDeviceSearchesList(),
+			"jamfpro_advanced_user_search":                       advancedusersearches.DataSourceJamfProAdvancedUserSearches(),
+			"jamfpro_advanced_user_searches":                     advancedusersearches.DataSourceJamfProAdvancedUserSearchesList(),
+			"jamfpro_api_integration":                            apiintegrations.DataSourceJamfProApiIntegrations(),
+			"jamfpro_api_integrations":                           apiintegrations.DataSourceJamfProApiIntegrationsList(),
+			"jamfpro_api_role":                                   apiroles.DataSourceJamfProAPIRoles(),
+			"jamfpro_api_roles":                                  apiroles.DataSourceJamfProAPIRolesList(),
+			"jamfpro_building":                                   buildings.DataSourceJamfProBuildings(),
+			"jamfpro_buildings":                                  buildings.DataSourceJamfProBuildingsList(),
+			"jamfpro_category":                                   categories.DataSourceJamfProCategories(),
+			"jamfpro_categories":                                 categories.DataSourceJamfProCategoriesList(),
+			"jamfpro_computer_extension_attribute":               computerextensionattributes.DataSourceJamfProComputerExtensionAttributes(),
+			"jamfpro_computer_extension_attributes":              computerextensionattributes.DataSourceJamfProComputerExtensionAttributesList(),
+			"jamfpro_computer_inventory":                         computerinventory.DataSourceJamfProComputerInventory(),
+			"jamfpro_computer_groups":                            computergroups.DataSourceJamfProComputerGroupsList(),
+			"jamfpro_computer_prestage_enrollment":               computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentEnrollment(),
+			"jamfpro_computer_prestage_enrollments":              computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentsList(),
+			"jamfpro_department":                                 departments.DataSourceJamfProDepartments(),
+			"jamfpro_departments":                                departments.DataSourceJamfProDepartmentsList(),
+			"jamfpro_disk_encryption_configuration":              diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurations(),
+			"jamfpro_disk_encryption_configurations":             diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurationsList(),
+			"jamfpro_dock_item":                                  dockitems.DataSourceJamfProDockItems(),
+			"jamfpro_dock_items":                                 dockitems.DataSourceJamfProDockItemsList(),
+			"jamfpro_file_share_distribution_point":              filesharedistributionpoints.DataSourceJamfProFileShareDistributionPoints(),
+			"jamfpro_file_share_distribution_points":             filesharedistributionpoints.DataSourceJamfProFileShareDistributionPointsList(),
+			"jamfpro_network_segment":                            networksegments.DataSourceJamfProNetworkSegments(),
+			"jamfpro_network_segments":                           networksegments.DataSourceJamfProNetworkSegmentsList(),
+			"jamfpro_macos_configuration_profile_plist":          macosconfigurationprofilesplist.DataSourceJamfProMacOSConfigurationProfilesPlist(),
+			"jamfpro_macos_configuration_profiles_plist":         macosconfigurationprofilesplist.DataSourceJamfProMacOSConfigurationProfilesPlistList(),
+			"jamfpro_mobile_device_configuration_profile_plist":  mobiledeviceconfigurationprofilesplist.DataSourceJamfProMobileDeviceConfigurationProfilesPlist(),
+			"jamfpro_mobile_device_configuration_profiles_plist": mobiledeviceconfigurationprofilesplist.DataSourceJamfProMobileDeviceConfigurationProfilesPlistList(),
+			"jamfpro_mobile_device_extension_attribute":          mobiledeviceextensionattributes.DataSourceJamfProMobileDeviceExtensionAttributes(),
+			"jamfpro_mobile_device_extension_attributes":         mobiledeviceextensionattributes.DataSourceJamfProMobileDeviceExtensionAttributesList(),
+			"jamfpro_mobile_device_prestage_enrollment":          mobiledeviceprestageenrollments.DataSourceJamfProMobileDevicePrestageEnrollment(),
+			"jamfpro_mobile_device_prestage_enrollments":         mobiledeviceprestageenrollments.DataSourceJamfProMobileDevicePrestageEnrollmentsList(),
+			"jamfpro_package":                                    packages.DataSourceJamfProPackages(),
+			"jamfpro_packages":                                   packages.DataSourceJamfProPackagesList(),
+			"jamfpro_policy":                                     policies.DataSourceJamfProPolicies(),
+			"jamfpro_patch_policies":                             patchpolicies.DataSourceJamfProPatchPoliciesList(),
+			"jamfpro_patch_software_title_configurations":        patchsoftwaretitleconfigurations.DataSourceJamfProPatchSoftwareTitleConfigurationsList(),
+			"jamfpro_policies":                                   policies.DataSourceJamfProPoliciesList(),
+			"jamfpro_printer":                                    printers.DataSourceJamfProPrinters(),
+			"jamfpro_printers":                                   printers.DataSourceJamfProPrintersList(),
+			"jamfpro_script":                                     scripts.DataSourceJamfProScripts(),
+			"jamfpro_scripts":                                    scripts.DataSourceJamfProScriptsList(),
+			"jamfpro_server_info":                                serverinfo.DataSourceJamfProServerInfo(),
+			"jamfpro_site":                                       sites.DataSourceJamfProSites(),
+			"jamfpro_sites":                                      sites.DataSourceJamfProSitesList(),
+			"jamfpro_smart_computer_group":                       smartcomputergroups.DataSourceJamfProSmartComputerGroups(),
+			"jamfpro_smart_computer_groups":                      smartcomputergroups.DataSourceJamfProSmartComputerGroupsList(),
+			"jamfpro_static_computer_group":                      staticcomputergroups.DataSourceJamfProStaticComputerGroups(),
+			"jamfpro_static_computer_groups":                     staticcomputergroups.DataSourceJamfProStaticComputerGroupsList(),
+			"jamfpro_smart_mobile_device_group":                  smartmobiledevicegroups.DataSourceJamfProSmartMobileDeviceGroups(),
+			"jamfpro_smart_mobile_device_groups":                 smartmobiledevicegroups.DataSourceJamfProSmartMobileDeviceGroupsList(),
+			"jamfpro_static_mobile_device_group":                 staticmobiledevicegroups.DataSourceJamfProStaticMobileDeviceGroups(),
+			"jamfpro_static_mobile_device_groups":                staticmobiledevicegroups.DataSourceJamfProStaticMobileDeviceGroupsList(),
+			"jamfpro_restricted_software":                        restrictedsoftware.DataSourceJamfProRestrictedSoftwares(),
+			"jamfpro_restricted_softwares":                       restrictedsoftware.DataSourceJamfProRestrictedSoftwaresList(),
+			"jamfpro_user_group":                                 usergroups.DataSourceJamfProUserGroups(),
+			"jamfpro_user_groups":                                usergroups.DataSourceJamfProUserGroupsList(),
+			"jamfpro_webhook":                                    webhooks.DataSourceJamfProWebhooks(),
+			"jamfpro_webhooks":                                   webhooks.DataSourceJamfProWebhooksList(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"jamfpro_account":                                     accounts.ResourceJamfProAccounts(),
@@ -378,6 +433,7 @@ func Provider() *schema.Provider {
 			"jamfpro_advanced_user_search":                        advancedusersearches.ResourceJamfProAdvancedUserSearches(),
 			"jamfpro_allowed_file_extension":                      allowedfileextensions.ResourceJamfProAllowedFileExtensions(),
 			"jamfpro_api_integration":                             apiintegrations.ResourceJamfProApiIntegrations(),
+			"jamfpro_api_integration_client_credentials":          apiintegrationclientcredentials.ResourceJamfProApiIntegrationClientCredentials(),
 			"jamfpro_api_role":                                    apiroles.ResourceJamfProAPIRoles(),
 			"jamfpro_building":                                    buildings.ResourceJamfProBuildings(),
 			"jamfpro_category":                                    categories.ResourceJamfProCategories(),
@@ -385,6 +441,7 @@ func Provider() *schema.Provider {
 			"jamfpro_computer_extension_attribute":                computerextensionattributes.ResourceJamfProComputerExtensionAttributes(),
 			"jamfpro_computer_inventory_collection":               computerinventorycollection.ResourceJamfProComputerInventoryCollection(),
 			"jamfpro_computer_prestage_enrollment":                computerprestageenrollments.ResourceJamfProComputerPrestageEnrollmentEnrollment(),
+			"jamfpro_computer_prestage_enrollment_scope":          computerprestageenrollmentscopes.ResourceJamfProComputerPrestageEnrollmentScope(),
 			"jamfpro_department":                                  departments.ResourceJamfProDepartments(),
 			"jamfpro_disk_encryption_configuration":               diskencryptionconfigurations.ResourceJamfProDiskEncryptionConfigurations(),
 			"jamfpro_dock_item":                                   dockitems.ResourceJamfProDockItems(),
@@ -393,99 +450,183 @@ func Provider() *schema.Provider {
 			"jamfpro_macos_configuration_profile_plist":           macosconfigurationprofilesplist.ResourceJamfProMacOSConfigurationProfilesPlist(),
 			"jamfpro_macos_configuration_profile_plist_generator": macosconfigurationprofilesplistgenerator.ResourceJamfProMacOSConfigurationProfilesPlistGenerator(),
 			"jamfpro_mobile_device_configuration_profile_plist":   mobiledeviceconfigurationprofilesplist.ResourceJamfProMobileDeviceConfigurationProfilesPlist(),
+			"jamfpro_mobile_device_extension_attribute":           mobiledeviceextensionattributes.ResourceJamfProMobileDeviceExtensionAttributes(),
+			"jamfpro_mobile_device_prestage_enrollment":           mobiledeviceprestageenrollments.ResourceJamfProMobileDevicePrestageEnrollment(),
 			"jamfpro_package":                                     packages.ResourceJamfProPackages(),
+			"jamfpro_patch_policy":                                patchpolicies.ResourceJamfProPatchPolicies(),
+			"jamfpro_patch_software_title_configuration":          patchsoftwaretitleconfigurations.ResourceJamfProPatchSoftwareTitleConfiguration(),
 			"jamfpro_policy":                                      policies.ResourceJamfProPolicies(),
 			"jamfpro_printer":                                     printers.ResourceJamfProPrinters(),
 			"jamfpro_script":                                      scripts.ResourceJamfProScripts(),
 			"jamfpro_site":                                        sites.ResourceJamfProSites(),
 			"jamfpro_smart_computer_group":                        smartcomputergroups.ResourceJamfProSmartComputerGroups(),
 			"jamfpro_static_computer_group":                       staticcomputergroups.ResourceJamfProStaticComputerGroups(),
+			"jamfpro_smart_mobile_device_group":                   smartmobiledevicegroups.ResourceJamfProSmartMobileDeviceGroups(),
+			"jamfpro_static_mobile_device_group":                  staticmobiledevicegroups.ResourceJamfProStaticMobileDeviceGroups(),
 			"jamfpro_restricted_software":                         restrictedsoftware.ResourceJamfProRestrictedSoftwares(),
+			"jamfpro_token_invalidation":                          tokeninvalidation.ResourceJamfProTokenInvalidation(),
 			"jamfpro_user_group":                                  usergroups.ResourceJamfProUserGroups(),
 			"jamfpro_webhook":                                     webhooks.ResourceJamfProWebhooks(),
 		},
 	}
 
+	// readOnly is set by ConfigureContextFunc, before any resource is created, updated or deleted.
+	var readOnly bool
+	guardWrites(provider.ResourcesMap, func() bool { return readOnly })
+
+	// configuredCtx is set by ConfigureContextFunc, before any resource or data source is read.
+	var configuredCtx context.Context
+	attachConfigured(provider.ResourcesMap, provider.DataSourcesMap, func() context.Context { return configuredCtx })
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var err error
 		var diags diag.Diagnostics
 		var jamfIntegration *jamfprointegration.Integration
-		var jamfFQDN,
-			clientId,
-			clientSecret,
-			basicAuthUsername,
-			basicAuthPassword string
 
 		// Logger
-		// Probably should move this into it's own function.
+		ctx, err = logging.Configure(ctx, logging.Config{
+			HideSensitiveData: d.Get("hide_sensitive_data").(bool),
+			ExportPath:        d.Get("client_sdk_log_export_path").(string),
+			ExportMaxSizeMB:   d.Get("log_export_max_size_mb").(int),
+			ExportMaxBackups:  d.Get("log_export_max_backups").(int),
+		})
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+		configuredCtx = ctx
+
+		sugaredLogger := logging.NewZapLogger(ctx).Sugar()
+
+		// Auth
+		creds, credsDiags := resolveCredentials(ctx, d)
+		diags = append(diags, credsDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		tokenRefrshBufferPeriod := time.Duration(d.Get("token_refresh_buffer_period_seconds").(int)) * time.Second
 
-		enableClientLogs := d.Get("enable_client_sdk_logs").(bool)
-		logFilePath := d.Get("client_sdk_log_export_path").(string)
+		// Network
+		networkConfig, networkDiags := transportConfigFrom(d)
+		diags = append(diags, networkDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		transport, err := newTransport(networkConfig)
+		if err != nil {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error configuring the connection to Jamf Pro",
+				Detail:   fmt.Sprintf("error: %v", err),
+			})
+		}
+		// Package downloads use the proxy and certificate authorities, but not the client certificate or the
+		// disabled verification meant for Jamf Pro.
+		externalTransport, err := newTransport(transportConfig{proxyURL: networkConfig.proxyURL, caBundles: networkConfig.caBundles})
+		if err != nil {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error configuring the connection to Jamf Pro",
+				Detail:   fmt.Sprintf("error: %v", err),
+			})
+		}
+		if networkConfig.insecureSkipVerify {
+			logging.Warnf(ctx, logging.SubsystemHTTP, "TLS certificate verification of %s is disabled", creds.FQDN)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "TLS certificate verification is disabled",
+				Detail: fmt.Sprintf("insecure_skip_verify is set, so the certificate of %s is not verified. Anyone on the network path "+
+					"can read and alter the requests of the provider, credentials included. Trust the certificate authority "+
+					"through ca_bundle_file or ca_bundle_pem instead.", creds.FQDN),
+			})
+		}
 
-		if !enableClientLogs && logFilePath != "" {
+		// Retries and throttling
+		retryBaseDelay := time.Duration(d.Get("retry_base_delay_milliseconds").(int)) * time.Millisecond
+		retryMaxDelay := time.Duration(d.Get("retry_max_delay_milliseconds").(int)) * time.Millisecond
+		if retryMaxDelay < retryBaseDelay {
 			return nil, append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Bad configuration",
-				Detail:   "Cannot have enable_client_sdk_logs disabled with client_sdk_log_export_path set",
+				Detail:   "retry_max_delay_milliseconds cannot be less than retry_base_delay_milliseconds",
 			})
 		}
 
-		defaultLoggerConfig := zap.NewProductionConfig()
-		var logLevel zap.AtomicLevel
-		if enableClientLogs {
-			logLevel = zap.NewAtomicLevelAt(zap.DebugLevel)
-		} else {
-			logLevel = zap.NewAtomicLevelAt(zap.FatalLevel)
+		policy := retryPolicy{
+			maxRetries: d.Get("max_retry_attempts").(int),
+			baseDelay:  retryBaseDelay,
+			maxDelay:   retryMaxDelay,
+			jitter:     d.Get("retry_jitter").(bool),
 		}
+		limiter := newRequestLimiter(
+			d.Get("max_concurrent_requests").(int),
+			d.Get("requests_per_second").(float64),
+			d.Get("request_burst").(int),
+		)
 
-		if logFilePath != "" {
-			if _, err := os.Stat(logFilePath); err != nil {
-				return nil, append(diags, diag.FromErr(err)...)
+		// Load balancer
+		load_balancer_lock_enabled := d.Get("jamfpro_load_balancer_lock").(bool)
+		var session *stickySession
+		if load_balancer_lock_enabled {
+			discoveryExecutor := &limitedExecutor{HTTPExecutor: newExecutor(transport), limiter: limiter}
+			session, err = newStickySession(ctx, func() ([]string, error) {
+				return discoverMembers(discoveryExecutor, creds.FQDN)
+			})
+			if err != nil {
+				return nil, append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Error locking to a Jamf Pro load balancer member",
+					Detail:   fmt.Sprintf("error: %v", err),
+				})
 			}
-			defaultLoggerConfig.OutputPaths = append(defaultLoggerConfig.OutputPaths, logFilePath)
 		}
-
-		defaultLoggerConfig.Level = logLevel
-		defaultLogger, err := defaultLoggerConfig.Build()
-
-		if err != nil {
-			return nil, append(diags, diag.FromErr(err)...)
+		sticky := func(executor httpclient.HTTPExecutor) httpclient.HTTPExecutor {
+			if session == nil {
+				return executor
+			}
+			return &stickyExecutor{HTTPExecutor: executor, session: session}
 		}
 
-		sugaredLogger := defaultLogger.Sugar()
-
-		// Auth
-		jamfFQDN = GetJamfFqdn(d, &diags)
-		authMethod := GetAuthMethod(d, &diags)
-		tokenRefrshBufferPeriod := time.Duration(d.Get("token_refresh_buffer_period_seconds").(int)) * time.Second
+		// Token cache
+		principal, secret := creds.ClientID, creds.ClientSecret
+		if creds.AuthMethod == "basic" {
+			principal, secret = creds.BasicAuthUsername, creds.BasicAuthPassword
+		}
+		tokenCacheKey := tokenCacheKey(creds.FQDN, creds.AuthMethod, principal, secret)
+		cached := func(executor httpclient.HTTPExecutor) *tokenCachingExecutor {
+			return &tokenCachingExecutor{
+				HTTPExecutor: executor,
+				cache:        tokens,
+				key:          tokenCacheKey,
+				dir:          d.Get("token_cache_dir").(string),
+				minLifetime:  tokenRefrshBufferPeriod + tokenCacheMargin,
+				ctx:          ctx,
+			}
+		}
 
 		hide_sensitive_data := d.Get("hide_sensitive_data").(bool)
-		bootstrapProdExecutor := &httpclient.ProdExecutor{Client: &http.Client{}}
-		switch authMethod {
+		authExecutor := &retryingExecutor{HTTPExecutor: sticky(newExecutor(transport)), policy: policy, limiter: limiter}
+		bootstrapExecutor := cached(authExecutor)
+		switch creds.AuthMethod {
 		case "oauth2":
-			clientId = GetClientID(d, &diags)
-			clientSecret = GetClientSecret(d, &diags)
 			jamfIntegration, err = jamfprointegration.BuildWithOAuth(
-				jamfFQDN,
+				creds.FQDN,
 				sugaredLogger,
 				tokenRefrshBufferPeriod,
-				clientId,
-				clientSecret,
+				creds.ClientID,
+				creds.ClientSecret,
 				hide_sensitive_data,
-				bootstrapProdExecutor,
+				bootstrapExecutor,
 			)
 
 		case "basic":
-			basicAuthUsername = GetBasicAuthUsername(d, &diags)
-			basicAuthPassword = GetBasicAuthPassword(d, &diags)
 			jamfIntegration, err = jamfprointegration.BuildWithBasicAuth(
-				jamfFQDN,
+				creds.FQDN,
 				sugaredLogger,
 				tokenRefrshBufferPeriod,
-				basicAuthUsername,
-				basicAuthPassword,
+				creds.BasicAuthUsername,
+				creds.BasicAuthPassword,
 				hide_sensitive_data,
-				bootstrapProdExecutor,
+				bootstrapExecutor,
 			)
 
 		default:
@@ -507,23 +648,8 @@ func Provider() *schema.Provider {
 
 		// Cookies
 		var cookiesList []*http.Cookie
-		load_balancer_lock_enabled := d.Get("jamfpro_load_balancer_lock").(bool)
 		customCookies := d.Get("custom_cookies")
 
-		if load_balancer_lock_enabled {
-			cookies, err := jamfIntegration.GetSessionCookies()
-			if err != nil {
-				return nil, append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Error getting session cookies",
-					Detail:   fmt.Sprintf("error: %v", err),
-				})
-			}
-
-			cookiesList = append(cookiesList, cookies...)
-
-		}
-
 		if customCookies != nil && len(customCookies.([]interface{})) > 0 {
 			for _, v := range customCookies.([]interface{}) {
 				name := v.(map[string]interface{})["name"]
@@ -545,16 +671,19 @@ func Provider() *schema.Provider {
 			}
 		}
 
-		// Amend timeouts
-		// TODO make this exclusions list a lot prettier.
-		// excludedResource := []string{"jamfpro_package"}
-		for key, r := range provider.ResourcesMap {
-			if key != "jamfpro_package" && key != "jamfpro_static_computer_group" && key != "jamfpro_smart_computer_group" {
-				*r.Timeouts.Create = GetDefaultContextTimeoutCreate(load_balancer_lock_enabled)
-				*r.Timeouts.Read = GetDefaultContextTimeoutRead(load_balancer_lock_enabled)
-				*r.Timeouts.Update = GetDefaultContextTimeoutUpdate(load_balancer_lock_enabled)
-				*r.Timeouts.Delete = GetDefaultContextTimeoutDelete(load_balancer_lock_enabled)
+		// Timeouts
+		applyDefaultTimeouts(d, provider.ResourcesMap, provider.DataSourcesMap)
+
+		// Read-only
+		readOnly = d.Get("read_only").(bool)
+		readOnlyGuard := func(executor httpclient.HTTPExecutor) httpclient.HTTPExecutor {
+			if !readOnly {
+				return executor
 			}
+			return &readOnlyExecutor{HTTPExecutor: executor}
+		}
+		if readOnly {
+			logging.Infof(ctx, logging.SubsystemCRUD, "The provider is read-only, Jamf Pro will not be changed")
 		}
 
 		// Packaging
@@ -565,8 +694,12 @@ func Provider() *schema.Provider {
 			TokenRefreshBufferPeriod: tokenRefrshBufferPeriod,
 			CustomCookies:            cookiesList,
 			MandatoryRequestDelay:    time.Duration(d.Get("mandatory_request_delay_milliseconds").(int)) * time.Millisecond,
-			RetryEligiableRequests:   false, // Forced off for now
-			HTTPExecutor:             &httpclient.ProdExecutor{Client: &http.Client{}},
+			RetryEligiableRequests:   false, // Retries are made by the retryingExecutor below.
+			HTTPExecutor: cached(readOnlyGuard(&retryingExecutor{
+				HTTPExecutor: &retryAfterExecutor{HTTPExecutor: sticky(newExecutor(transport))},
+				policy:       policy,
+				limiter:      limiter,
+			})),
 		}
 
 		goHttpClient, err := config.Build()
@@ -577,6 +710,31 @@ func Provider() *schema.Provider {
 		jamfClient := jamfpro.Client{
 			HTTP: goHttpClient,
 		}
+		externaltransport.Register(&jamfClient, externalTransport)
+		tokencache.Register(&jamfClient, func() error {
+			return bootstrapExecutor.invalidate(authExecutor, creds.FQDN)
+		})
+
+		// Jamf Pro version
+		validate := d.Get("validate_on_configure").(bool)
+		server, versionDiags := readServerVersion(ctx, &jamfClient, creds.FQDN)
+		switch {
+		case versionDiags.HasError() && validate:
+			return nil, append(diags, versionDiags...)
+		case versionDiags.HasError():
+			logging.Warnf(ctx, logging.SubsystemHTTP, "%s Attributes depending on the Jamf Pro version will not be checked.", versionDiags[0].Detail)
+		default:
+			configuredCtx = jamfversion.WithServer(configuredCtx, server)
+		}
+
+		// Validation
+		if selected := d.Get("validate_privileges_for").(*schema.Set); selected.Len() > 0 && creds.AuthMethod == "oauth2" {
+			resources := make([]string, 0, selected.Len())
+			for _, name := range selected.List() {
+				resources = append(resources, name.(string))
+			}
+			diags = append(diags, validatePrivileges(&jamfClient, creds.ClientID, resources)...)
+		}
 
 		return &jamfClient, diags
 	}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// mockProviderConfig returns the provider configuration for authenticating against a mock Jamf Pro with OAuth.
+func mockProviderConfig(server *mockjamfpro.Server) map[string]interface{} {
+	return map[string]interface{}{
+		"jamfpro_instance_fqdn":                mockjamfpro.DefaultFQDN,
+		"auth_method":                          "oauth2",
+		"client_id":                            server.ClientID,
+		"client_secret":                        server.ClientSecret,
+		"mandatory_request_delay_milliseconds": 0,
+		"token_cache_dir":                      "",
+	}
+}
+
+// newMockProvider returns a provider whose requests are served by the given mock Jamf Pro.
+func newMockProvider(server *mockjamfpro.Server) *schema.Provider {
+	return newProvider(func(*http.Transport) httpclient.HTTPExecutor { return server.Executor() }, newTokenCache())
+}
+
+// configureMockProvider returns a provider configured against a new mock Jamf Pro, along with the server.
+func configureMockProvider(t *testing.T) (*schema.Provider, *mockjamfpro.Server) {
+	t.Helper()
+
+	server := mockjamfpro.New()
+	provider := newMockProvider(server)
+
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(mockProviderConfig(server))); diags.HasError() {
+		t.Fatalf("failed to configure provider against mock Jamf Pro: %v", diags)
+	}
+
+	return provider, server
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("provider schema is invalid: %v", err)
+	}
+}
+
+func TestProviderConfigureOAuth(t *testing.T) {
+	provider, _ := configureMockProvider(t)
+
+	if _, ok := provider.Meta().(*jamfpro.Client); !ok {
+		t.Fatalf("expected the provider meta to be a *jamfpro.Client, got %T", provider.Meta())
+	}
+}
+
+func TestProviderConfigureBasicAuth(t *testing.T) {
+	server := mockjamfpro.New()
+	provider := newMockProvider(server)
+
+	config := map[string]interface{}{
+		"jamfpro_instance_fqdn":                mockjamfpro.DefaultFQDN,
+		"auth_method":                          "basic",
+		"basic_auth_username":                  server.Username,
+		"basic_auth_password":                  server.Password,
+		"mandatory_request_delay_milliseconds": 0,
+		"token_cache_dir":                      "",
+	}
+
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("failed to configure provider with basic auth: %v", diags)
+	}
+}
+
+func TestProviderConfigureRejectsInvalidCredentials(t *testing.T) {
+	server := mockjamfpro.New()
+	provider := newMockProvider(server)
+
+	config := mockProviderConfig(server)
+	config["client_secret"] = "wrong"
+
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+		t.Fatal("expected configuring the provider with an invalid client secret to fail")
+	}
+}
+
+func TestProviderDefaultTimeouts(t *testing.T) {
+	server := mockjamfpro.New()
+	provider := newMockProvider(server)
+
+	config := mockProviderConfig(server)
+	config["default_timeouts"] = []interface{}{map[string]interface{}{"create": "7m", "read": "90s"}}
+
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("failed to configure provider with default timeouts: %v", diags)
+	}
+
+	r := provider.ResourcesMap["jamfpro_category"]
+	if *r.Timeouts.Create != 7*time.Minute || *r.Timeouts.Read != 90*time.Second {
+		t.Fatalf("expected the default timeouts to apply, got create %s and read %s", *r.Timeouts.Create, *r.Timeouts.Read)
+	}
+	if *r.Timeouts.Update != 2*time.Minute || *r.Timeouts.Delete != time.Minute {
+		t.Fatalf("expected the declared update and delete timeouts to be raised to 2m and 1m, got %s and %s", *r.Timeouts.Update, *r.Timeouts.Delete)
+	}
+	if read := *provider.DataSourcesMap["jamfpro_script"].Timeouts.Read; read != 90*time.Second {
+		t.Fatalf("expected the default read timeout to apply to data sources, got %s", read)
+	}
+
+	// Package uploads keep their longer defaults rather than being cut to the provider's.
+	packages := provider.ResourcesMap["jamfpro_package"]
+	if *packages.Timeouts.Create != 45*time.Minute || *packages.Timeouts.Read != 90*time.Second {
+		t.Fatalf("expected the package to keep its 45m create timeout and take the 90s read timeout, got create %s and read %s", *packages.Timeouts.Create, *packages.Timeouts.Read)
+	}
+
+	// A timeouts block within the resource still wins over the provider defaults.
+	resourceConfig := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "tf-mock-category",
+		"timeouts": map[string]interface{}{"create": "2m"},
+	})
+	diff, err := r.Diff(context.Background(), nil, resourceConfig, provider.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var timeouts schema.ResourceTimeout
+	if err := timeouts.DiffDecode(diff); err != nil {
+		t.Fatal(err)
+	}
+	if *timeouts.Create != 2*time.Minute || *timeouts.Read != 90*time.Second {
+		t.Fatalf("expected create 2m from the resource and read 90s from the provider, got create %s and read %s", *timeouts.Create, *timeouts.Read)
+	}
+}
+
+func TestProviderMinimumTimeouts(t *testing.T) {
+	provider, _ := configureMockProvider(t)
+
+	for name, r := range provider.ResourcesMap {
+		if r.Timeouts == nil {
+			continue
+		}
+		for _, key := range timeoutKeys {
+			if timeout := timeoutOf(r.Timeouts, key); timeout != nil && *timeout < minimumTimeouts[key] {
+				t.Errorf("%s %s timeout is %s, expected at least %s", name, key, *timeout, minimumTimeouts[key])
+			}
+		}
+	}
+	if create := *provider.ResourcesMap["jamfpro_policy"].Timeouts.Create; create != 5*time.Minute {
+		t.Fatalf("expected longer declared timeouts to be kept, got %s", create)
+	}
+}
+
+func TestSmartMobileDeviceGroupCriteriaPriority(t *testing.T) {
+	provider, _ := configureMockProvider(t)
+	r := provider.ResourcesMap["jamfpro_smart_mobile_device_group"]
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "tf-mock-smart-mobile-device-group",
+		"criteria": []interface{}{
+			map[string]interface{}{"name": "Model", "priority": 0, "value": "iPad"},
+			map[string]interface{}{"name": "OS Version", "priority": 2, "value": "17.0"},
+		},
+	})
+	if _, err := r.Diff(context.Background(), nil, config, provider.Meta()); err == nil {
+		t.Fatal("expected criteria priorities skipping 1 to be rejected when planning")
+	}
+}
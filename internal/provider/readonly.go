@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const envVarReadOnly = "JAMFPRO_READ_ONLY"
+
+// guardWrites wraps the create, update and delete functions of every resource so that they fail without a request
+// to Jamf Pro while readOnly reports true. Reads, imports and data sources are left alone.
+func guardWrites(resources map[string]*schema.Resource, readOnly func() bool) {
+	for resourceType, r := range resources {
+		r.CreateContext = refuseWhenReadOnly(r.CreateContext, resourceType, "create", readOnly)
+		r.UpdateContext = refuseWhenReadOnly(r.UpdateContext, resourceType, "update", readOnly)
+		r.DeleteContext = refuseWhenReadOnly(r.DeleteContext, resourceType, "delete", readOnly)
+	}
+}
+
+// crudFunc is the signature shared by the create, update and delete functions of a resource.
+type crudFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// refuseWhenReadOnly returns a CRUD function calling operation unless readOnly reports true, nil if operation is nil.
+func refuseWhenReadOnly(operation crudFunc, resourceType, name string, readOnly func() bool) crudFunc {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if !readOnly() {
+			return operation(ctx, d, meta)
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot %s %s: the provider is read-only", name, resourceType),
+			Detail: fmt.Sprintf("read_only is set on the provider (or %s in the environment), so resources can be read and "+
+				"planned but not changed. Unset it to apply changes.", envVarReadOnly),
+		}}
+	}
+}
+
+// readOnlyExecutor wraps an HTTPExecutor, refusing every request which may change an object in Jamf Pro. It backs
+// up guardWrites for code paths which write outside of a resource create, update or delete.
+type readOnlyExecutor struct {
+	httpclient.HTTPExecutor
+}
+
+// Do sends GET, HEAD and OPTIONS requests and refuses any other.
+func (e *readOnlyExecutor) Do(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return e.HTTPExecutor.Do(req)
+	}
+
+	return nil, fmt.Errorf("refusing %s %s as the provider is read-only", req.Method, req.URL.Path)
+}
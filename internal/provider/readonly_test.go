@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProviderReadOnly(t *testing.T) {
+	ctx := context.Background()
+	writable, server := configureMockProvider(t)
+
+	category := map[string]interface{}{"name": "tf-mock-category", "priority": 9}
+	state := applyLifecycleStep(ctx, t, writable.ResourcesMap["jamfpro_category"], nil, category, writable.Meta(), "create")
+
+	t.Setenv(envVarReadOnly, "true")
+	provider := newMockProvider(server)
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(mockProviderConfig(server))); diags.HasError() {
+		t.Fatalf("failed to configure read-only provider: %v", diags)
+	}
+	meta := provider.Meta()
+	r := provider.ResourcesMap["jamfpro_category"]
+	writes := server.RequestCount("POST /api/v1/categories") + server.RequestCount("DELETE /api/v1/categories/"+state.ID)
+
+	refreshed, diags := r.RefreshWithoutUpgrade(ctx, state, meta)
+	if diags.HasError() || refreshed == nil || refreshed.ID != state.ID {
+		t.Fatalf("expected the category to be read by the read-only provider: %v", diags)
+	}
+
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "tf-mock-category-2"}), meta)
+	if err != nil {
+		t.Fatalf("expected the read-only provider to plan: %v", err)
+	}
+	if _, diags := r.Apply(ctx, nil, diff, meta); !diags.HasError() || !strings.Contains(diags[0].Summary, "read-only") {
+		t.Fatalf("expected create to be refused, got %v", diags)
+	}
+	if _, diags := r.Apply(ctx, refreshed, &terraform.InstanceDiff{Destroy: true}, meta); !diags.HasError() {
+		t.Fatal("expected delete to be refused")
+	}
+
+	// Writes made outside of a resource are refused before reaching Jamf Pro.
+	if _, err := meta.(*jamfpro.Client).CreateCategory(&jamfpro.ResourceCategory{Name: "tf-mock-category-3"}); err == nil {
+		t.Fatal("expected the client of the read-only provider to refuse writes")
+	}
+
+	if got := server.RequestCount("POST /api/v1/categories") + server.RequestCount("DELETE /api/v1/categories/"+state.ID); got != writes {
+		t.Fatalf("expected no write to reach Jamf Pro, %d did", got-writes)
+	}
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+)
+
+// retryPolicy defines how often and how patiently a failed request is repeated.
+type retryPolicy struct {
+	// maxRetries is the number of times a request is repeated after its first attempt.
+	maxRetries int
+	// baseDelay is the backoff before the first retry, doubled for every further retry up to maxDelay.
+	baseDelay time.Duration
+	maxDelay  time.Duration
+	// jitter randomises each backoff between half and all of its length so concurrent requests spread out.
+	jitter bool
+}
+
+// backoff returns the delay before the given retry, counting from 0.
+func (p retryPolicy) backoff(retry int) time.Duration {
+	delay := p.baseDelay
+	for i := 0; i < retry && delay < p.maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.maxDelay {
+		delay = p.maxDelay
+	}
+
+	if p.jitter && delay > 1 {
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+
+	return delay
+}
+
+// requestLimiter caps how many requests are in flight and how fast they are sent. A provider shares one
+// limiter between its executors so authentication requests count towards the same limits.
+type requestLimiter struct {
+	// slots holds a token for every request in flight, nil for no cap.
+	slots chan struct{}
+	// bucket paces requests, nil for no rate limit.
+	bucket *tokenBucket
+}
+
+// newRequestLimiter returns a limiter allowing maxConcurrent requests in flight, unlimited if 0, at
+// requestsPerSecond with bursts of up to burst requests, unlimited if requestsPerSecond is 0.
+func newRequestLimiter(maxConcurrent int, requestsPerSecond float64, burst int) *requestLimiter {
+	limiter := &requestLimiter{}
+	if maxConcurrent > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		limiter.bucket = newTokenBucket(requestsPerSecond, burst)
+	}
+	return limiter
+}
+
+// acquire waits for a free slot and a token, returning a function which releases the slot.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// limitedExecutor wraps an HTTPExecutor, pacing requests through a requestLimiter without retrying them. It carries
+// requests made on the side of the API client, such as load balancer discovery, so they count towards the limits.
+type limitedExecutor struct {
+	httpclient.HTTPExecutor
+	limiter *requestLimiter
+}
+
+// Do sends the request once a slot and a token are available.
+func (e *limitedExecutor) Do(req *http.Request) (*http.Response, error) {
+	release, err := e.limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return e.HTTPExecutor.Do(req)
+}
+
+// tokenBucket is a token bucket rate limiter refilled continuously at rate tokens per second.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full bucket.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// wait takes a token from the bucket, blocking until one is available.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		shortfall := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := sleepContext(ctx, shortfall); err != nil {
+			return err
+		}
+	}
+}
+
+// retryingExecutor wraps an HTTPExecutor, pacing requests through a requestLimiter and repeating those
+// failing with a retryable error according to a retryPolicy.
+//
+// Responses are retried as common.IsRetryableStatus allows, the same table resources classify errors by. Network
+// errors may arrive after the request took effect, so they are only retried for idempotent methods. Requests with a streamed body, such as package uploads, cannot be replayed and
+// are sent once.
+type retryingExecutor struct {
+	httpclient.HTTPExecutor
+	policy  retryPolicy
+	limiter *requestLimiter
+}
+
+// Do sends the request, retrying it as the policy allows. A Retry-After header lengthens the backoff before the
+// next attempt. When the retries run out the request is flagged so the resource does not retry it once more.
+func (e *retryingExecutor) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for retry := 0; ; retry++ {
+		attempt := req
+		if retry > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attempt = req.Clone(ctx)
+			attempt.Body = body
+		}
+
+		release, err := e.limiter.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := e.HTTPExecutor.Do(attempt)
+		release()
+
+		if !isRetryableAttempt(req.Method, resp, err) {
+			return resp, err
+		}
+
+		if !replayable || retry >= e.policy.maxRetries {
+			common.RecordRetriesExhausted(req.Method, req.URL.String())
+			return resp, err
+		}
+
+		delay := e.policy.backoff(retry)
+		if resp != nil {
+			if retryAfter, ok := common.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok && retryAfter > delay {
+				delay = retryAfter
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isRetryableAttempt reports whether the outcome of a request is worth repeating.
+func isRetryableAttempt(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return common.IsIdempotentMethod(method) && common.IsTransientNetworkError(err)
+	}
+
+	return common.IsRetryableStatus(method, resp.StatusCode)
+}
+
+// sleepContext waits for the duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+)
+
+// scriptedExecutor answers requests with a fixed sequence of status codes, repeating the last one.
+type scriptedExecutor struct {
+	httpclient.HTTPExecutor
+	statuses []int
+	headers  http.Header
+	bodies   []string
+}
+
+func (e *scriptedExecutor) Do(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		content, _ := io.ReadAll(req.Body)
+		body = string(content)
+	}
+	e.bodies = append(e.bodies, body)
+
+	status := e.statuses[len(e.statuses)-1]
+	if len(e.bodies) <= len(e.statuses) {
+		status = e.statuses[len(e.bodies)-1]
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     e.headers,
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func newTestRetryingExecutor(scripted *scriptedExecutor, maxRetries int) *retryingExecutor {
+	return &retryingExecutor{
+		HTTPExecutor: scripted,
+		policy:       retryPolicy{maxRetries: maxRetries, baseDelay: time.Millisecond, maxDelay: 5 * time.Millisecond},
+		limiter:      newRequestLimiter(0, 0, 0),
+	}
+}
+
+func TestRetryingExecutorRetriesServiceUnavailable(t *testing.T) {
+	scripted := &scriptedExecutor{statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}}
+	executor := newTestRetryingExecutor(scripted, 3)
+
+	req, _ := http.NewRequest(http.MethodPost, "https://example.jamfcloud.com/api/v1/categories", strings.NewReader(`{"name":"a"}`))
+	resp, err := executor.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 after retries, got %d", resp.StatusCode)
+	}
+	if len(scripted.bodies) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(scripted.bodies))
+	}
+	for i, body := range scripted.bodies {
+		if body != `{"name":"a"}` {
+			t.Fatalf("attempt %d sent body %q", i+1, body)
+		}
+	}
+}
+
+func TestRetryingExecutorDoesNotRetryNonIdempotentServerError(t *testing.T) {
+	scripted := &scriptedExecutor{statuses: []int{http.StatusInternalServerError, http.StatusOK}}
+	executor := newTestRetryingExecutor(scripted, 3)
+
+	req, _ := http.NewRequest(http.MethodPost, "https://example.jamfcloud.com/JSSResource/policies/id/0", strings.NewReader("<policy/>"))
+	resp, err := executor.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError || len(scripted.bodies) != 1 {
+		t.Fatalf("expected a single attempt returning 500, got %d attempts returning %d", len(scripted.bodies), resp.StatusCode)
+	}
+
+	// The resources' own retry loops must not repeat the create either.
+	sdkErr := errors.New(`failed to create policy: {"status_code":500,"method":"POST","url":"` + req.URL.String() + `","message":"Internal Server Error"}`)
+	if common.ClassifyError(sdkErr).Retryable {
+		t.Fatal("expected a server error on a POST to be non-retryable")
+	}
+}
+
+func TestRetryingExecutorStopsAfterMaxRetries(t *testing.T) {
+	scripted := &scriptedExecutor{statuses: []int{http.StatusBadGateway}}
+	executor := newTestRetryingExecutor(scripted, 2)
+
+	url := "https://example.jamfcloud.com/api/v1/buildings/7"
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	resp, err := executor.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadGateway || len(scripted.bodies) != 3 {
+		t.Fatalf("expected 3 attempts returning 502, got %d attempts returning %d", len(scripted.bodies), resp.StatusCode)
+	}
+
+	// The SDK turns the final response into an error, which the resources must not retry again.
+	sdkErr := errors.New(`failed to get building: {"status_code":502,"method":"GET","url":"` + url + `","message":"Bad Gateway"}`)
+	if common.ClassifyError(sdkErr).Retryable {
+		t.Fatal("expected an error whose retries were exhausted by the HTTP layer to be non-retryable")
+	}
+}
+
+func TestRetryingExecutorHonoursRetryAfter(t *testing.T) {
+	scripted := &scriptedExecutor{
+		statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+		headers:  http.Header{"Retry-After": []string{"1"}},
+	}
+	executor := newTestRetryingExecutor(scripted, 1)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.jamfcloud.com/api/v1/scripts", nil)
+	start := time.Now()
+	if _, err := executor.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected the retry to wait for the Retry-After delay, waited %s", elapsed)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{baseDelay: 100 * time.Millisecond, maxDelay: time.Second}
+
+	for retry, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		if got := policy.backoff(retry); got != want*time.Millisecond {
+			t.Errorf("backoff(%d) = %s, expected %s", retry, got, want*time.Millisecond)
+		}
+	}
+
+	policy.jitter = true
+	for i := 0; i < 100; i++ {
+		if got := policy.backoff(2); got < 200*time.Millisecond || got > 400*time.Millisecond {
+			t.Fatalf("jittered backoff(2) = %s, expected between 200ms and 400ms", got)
+		}
+	}
+}
+
+func TestTokenBucketPacesRequests(t *testing.T) {
+	limiter := newRequestLimiter(1, 20, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		release, err := limiter.acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+
+	// Two requests fit the burst, the other two wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("expected 4 requests at 20 per second with a burst of 2 to take about 100ms, took %s", elapsed)
+	}
+}
+
+func TestRetryAfterHintForgottenOnSuccess(t *testing.T) {
+	scripted := &scriptedExecutor{
+		statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+		headers:  http.Header{"Retry-After": []string{"120"}},
+	}
+	executor := &retryAfterExecutor{HTTPExecutor: scripted}
+
+	url := "https://example.jamfcloud.com/api/v1/sites"
+	for range scripted.statuses {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		if _, err := executor.Do(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A later failure of the same request must not inherit the Retry-After of the earlier 429.
+	sdkErr := errors.New(`failed to get sites: {"status_code":503,"method":"GET","url":"` + url + `","message":"Service Unavailable"}`)
+	if delay := common.ClassifyError(sdkErr).RetryAfter; delay != 0 {
+		t.Fatalf("expected no Retry-After hint after a successful request, got %s", delay)
+	}
+}
+
+func TestRetryLayersAgreeOnRetryableRequests(t *testing.T) {
+	methods := []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	statuses := []int{
+		http.StatusBadRequest, http.StatusRequestTimeout, http.StatusConflict, http.StatusLocked, http.StatusTooManyRequests,
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout,
+	}
+
+	url := "https://example.jamfcloud.com/api/v1/departments/3"
+	for _, method := range methods {
+		for _, status := range statuses {
+			scripted := &scriptedExecutor{statuses: []int{status, http.StatusOK}}
+			req, _ := http.NewRequest(method, url, nil)
+			if _, err := newTestRetryingExecutor(scripted, 1).Do(req); err != nil {
+				t.Fatal(err)
+			}
+			retriedByHTTPLayer := len(scripted.bodies) == 2
+
+			// Classify as a resource would had the HTTP layer been configured not to retry at all.
+			common.ForgetRequestHint(method, url)
+			sdkErr := errors.New(`request failed: {"status_code":` + strconv.Itoa(status) + `,"method":"` + method + `","url":"` + url + `"}`)
+			retriedByResource := common.ClassifyError(sdkErr).Retryable
+
+			// Conflicts are left to the resources, which read the object again before repeating the request.
+			if status == http.StatusConflict {
+				if retriedByHTTPLayer || !retriedByResource {
+					t.Errorf("%s %d: expected only the resource to retry a conflict", method, status)
+				}
+				continue
+			}
+			if retriedByHTTPLayer != retriedByResource {
+				t.Errorf("%s %d: the HTTP layer retries it is %t, the resource retries it is %t", method, status, retriedByHTTPLayer, retriedByResource)
+			}
+		}
+	}
+
+	// Network errors are retried by the HTTP layer alone, so a resource does not multiply its attempts.
+	if common.ClassifyError(errors.New(`Get "` + url + `": read: connection reset by peer`)).Retryable {
+		t.Fatal("expected a network error to be non-retryable for the resource")
+	}
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+)
+
+const (
+	// loadBalancerPolls is the number of requests sent to the load balancer to discover its web app members.
+	loadBalancerPolls = 5
+	// rediscoveryCooldown is how long a failed member neither triggers another discovery nor is pinned again.
+	rediscoveryCooldown = time.Minute
+)
+
+/*
+stickySession pins the requests of a provider to one web app member of the Jamf Cloud load balancer, so that
+objects written by one request are visible to the next. The member is named by the jpro-ingress cookie.
+
+The pin is replaced when the member goes away during a run:
+
+  - when the load balancer routes a request elsewhere and sets a new jpro-ingress cookie, that member is pinned;
+  - when a request to the member fails with a 502, 503 or 504 response or a network error, the members are
+    discovered again in the background and the first one which has not failed recently is pinned.
+
+A 503 response carrying Retry-After is Jamf Pro asking for patience, not a member going away, so it keeps the pin.
+A member which failed is not discovered again for it nor pinned for rediscoveryCooldown, so a run of failures
+re-pins once rather than on every response. Retries of the failed request, made by the retryingExecutor, are sent
+to the new member once it is pinned.
+*/
+type stickySession struct {
+	mu sync.Mutex
+	// member is the pinned web app member, empty while none is known.
+	member string
+	// discovering is set while the members are being discovered again.
+	discovering bool
+	// failedAt holds when each member last triggered a discovery.
+	failedAt map[string]time.Time
+	// pending tracks the discoveries running in the background.
+	pending sync.WaitGroup
+	// discover returns the web app members the load balancer routes to.
+	discover func() ([]string, error)
+	// ctx is the provider context the session logs with.
+	ctx context.Context
+}
+
+// newStickySession discovers the members of the load balancer and pins the first of them in sort order, so that
+// every provider instance configured for the same Jamf Pro pins the same member.
+func newStickySession(ctx context.Context, discover func() ([]string, error)) (*stickySession, error) {
+	s := &stickySession{discover: discover, ctx: ctx, failedAt: make(map[string]time.Time)}
+
+	members, err := discover()
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover the load balancer members: %v", err)
+	}
+
+	s.member = members[0]
+	logging.Infof(s.ctx, logging.SubsystemHTTP, "Pinned Jamf Pro requests to load balancer member %s of %s", s.member, strings.Join(members, ", "))
+
+	return s, nil
+}
+
+// pinned returns the pinned member.
+func (s *stickySession) pinned() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.member
+}
+
+// rerouted pins the member the load balancer routed a request for the pinned member to.
+func (s *stickySession) rerouted(from, to string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.member != from || to == "" || to == from {
+		return
+	}
+
+	s.member = to
+	logging.Warnf(s.ctx, logging.SubsystemHTTP, "Jamf Pro load balancer member %s is no longer served, pinned requests to member %s", from, to)
+}
+
+// failed starts discovering the members again after a request to the given member failed, unless the member is
+// no longer pinned, a discovery is running or the member failed within rediscoveryCooldown. Discovery runs in the
+// background as the failed request may hold the last slot of the request limiter discovery is paced by.
+func (s *stickySession) failed(member string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.member != member || s.discovering || s.recentlyFailed(member, time.Now()) {
+		return
+	}
+	s.failedAt[member] = time.Now()
+	s.discovering = true
+
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		s.repin(member)
+	}()
+}
+
+// repin discovers the members and pins the first one which has not failed recently in place of member.
+func (s *stickySession) repin(member string) {
+	members, err := s.discover()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.discovering = false
+
+	if err != nil {
+		logging.Warnf(s.ctx, logging.SubsystemHTTP, "Jamf Pro load balancer member %s failed and the members could not be discovered again, keeping it pinned: %v", member, err)
+		return
+	}
+	if s.member != member {
+		return
+	}
+
+	now := time.Now()
+	for _, candidate := range members {
+		if candidate != member && !s.recentlyFailed(candidate, now) {
+			s.member = candidate
+			logging.Warnf(s.ctx, logging.SubsystemHTTP, "Jamf Pro load balancer member %s failed, pinned requests to member %s", member, candidate)
+			return
+		}
+	}
+
+	logging.Warnf(s.ctx, logging.SubsystemHTTP, "Jamf Pro load balancer member %s failed but no other member is available, keeping it pinned", member)
+}
+
+// recentlyFailed reports whether member failed within rediscoveryCooldown of now. s.mu must be held.
+func (s *stickySession) recentlyFailed(member string, now time.Time) bool {
+	at, ok := s.failedAt[member]
+	return ok && now.Sub(at) < rediscoveryCooldown
+}
+
+// discoverMembers polls the load balancer in front of a Jamf Pro instance and returns the web app members named by
+// the jpro-ingress cookies it sets, sorted. The requests carry no cookies, so the load balancer spreads them.
+func discoverMembers(executor httpclient.HTTPExecutor, fqdn string) ([]string, error) {
+	found := make(map[string]bool)
+	var lastErr error
+
+	for i := 0; i < loadBalancerPolls; i++ {
+		req, err := http.NewRequest(http.MethodGet, fqdn, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := executor.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+
+		if member := memberOf(resp); member != "" {
+			found[member] = true
+		}
+	}
+
+	if len(found) == 0 {
+		if lastErr != nil {
+			return nil, lastErr
+		}
+		return nil, fmt.Errorf("%s did not set a %s cookie", fqdn, jamfLoadBalancerCookieName)
+	}
+
+	members := make([]string, 0, len(found))
+	for member := range found {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+
+	return members, nil
+}
+
+// memberOf returns the member named by the jpro-ingress cookie set by a response, if any.
+func memberOf(resp *http.Response) string {
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == jamfLoadBalancerCookieName {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+// stickyExecutor wraps an HTTPExecutor, sending every request to the member pinned by a stickySession. It keeps
+// the jpro-ingress cookie out of the cookie jar of the executor so the jar cannot override the pin.
+type stickyExecutor struct {
+	httpclient.HTTPExecutor
+	session *stickySession
+}
+
+// Do sends the request to the pinned member and replaces the pin if the member went away.
+func (e *stickyExecutor) Do(req *http.Request) (*http.Response, error) {
+	member := e.session.pinned()
+	if member == "" {
+		return e.HTTPExecutor.Do(req)
+	}
+
+	pinned := req.Clone(req.Context())
+	setCookie(pinned, &http.Cookie{Name: jamfLoadBalancerCookieName, Value: member})
+
+	resp, err := e.HTTPExecutor.Do(pinned)
+	switch {
+	case err != nil:
+		e.session.failed(member)
+	case resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != "":
+		e.session.rerouted(member, memberOf(resp))
+	case resp.StatusCode == http.StatusBadGateway, resp.StatusCode == http.StatusServiceUnavailable, resp.StatusCode == http.StatusGatewayTimeout:
+		e.session.failed(member)
+	default:
+		e.session.rerouted(member, memberOf(resp))
+	}
+
+	return resp, err
+}
+
+// SetCookieJar hands the executor a jar which ignores jpro-ingress cookies.
+func (e *stickyExecutor) SetCookieJar(jar http.CookieJar) {
+	e.HTTPExecutor.SetCookieJar(&unpinnedJar{CookieJar: jar})
+}
+
+// setCookie sets a cookie on a request, replacing any cookie of the same name.
+func setCookie(req *http.Request, cookie *http.Cookie) {
+	cookies := req.Cookies()
+	req.Header.Del("Cookie")
+	for _, existing := range cookies {
+		if existing.Name != cookie.Name {
+			req.AddCookie(existing)
+		}
+	}
+	req.AddCookie(cookie)
+}
+
+// unpinnedJar is a cookie jar which neither stores nor sends jpro-ingress cookies.
+type unpinnedJar struct {
+	http.CookieJar
+}
+
+// SetCookies stores the cookies other than jpro-ingress.
+func (j *unpinnedJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.CookieJar.SetCookies(u, withoutLoadBalancerCookie(cookies))
+}
+
+// Cookies returns the cookies for u other than jpro-ingress.
+func (j *unpinnedJar) Cookies(u *url.URL) []*http.Cookie {
+	return withoutLoadBalancerCookie(j.CookieJar.Cookies(u))
+}
+
+// withoutLoadBalancerCookie returns the cookies other than jpro-ingress.
+func withoutLoadBalancerCookie(cookies []*http.Cookie) []*http.Cookie {
+	kept := make([]*http.Cookie, 0, len(cookies))
+	for _, cookie := range cookies {
+		if cookie.Name != jamfLoadBalancerCookieName {
+			kept = append(kept, cookie)
+		}
+	}
+	return kept
+}
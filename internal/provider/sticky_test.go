@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// memberRecordingExecutor records the jpro-ingress cookie of each request and answers with a fixed status, or the
+// status set for the member the request is pinned to.
+type memberRecordingExecutor struct {
+	httpclient.HTTPExecutor
+	status       int
+	memberStatus map[string]int
+	header       http.Header
+	mu           sync.Mutex
+	members      []string
+}
+
+func (e *memberRecordingExecutor) Do(req *http.Request) (*http.Response, error) {
+	cookie, _ := req.Cookie(jamfLoadBalancerCookieName)
+	e.mu.Lock()
+	e.members = append(e.members, cookie.Value)
+	e.mu.Unlock()
+
+	status := e.status
+	if memberStatus, ok := e.memberStatus[cookie.Value]; ok {
+		status = memberStatus
+	}
+	header := e.header
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+// countingDiscovery returns a discovery function always finding the given members, and a function returning how
+// often it was called.
+func countingDiscovery(members ...string) (func() ([]string, error), func() int) {
+	var calls int32
+	discover := func() ([]string, error) {
+		atomic.AddInt32(&calls, 1)
+		return members, nil
+	}
+	return discover, func() int { return int(atomic.LoadInt32(&calls)) }
+}
+
+// sendPinned sends a request through the executor and waits for the discoveries it started.
+func sendPinned(t *testing.T, executor *stickyExecutor) {
+	t.Helper()
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.jamfcloud.com/api/v1/categories", nil)
+	if _, err := executor.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	executor.session.pending.Wait()
+}
+
+func TestStickyExecutorRepinsAfterFailure(t *testing.T) {
+	session, err := newStickySession(context.Background(), func() ([]string, error) { return []string{"web-a", "web-b"}, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &memberRecordingExecutor{status: http.StatusServiceUnavailable}
+	executor := &stickyExecutor{HTTPExecutor: recorder, session: session}
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://example.jamfcloud.com/api/v1/categories", nil)
+		req.AddCookie(&http.Cookie{Name: jamfLoadBalancerCookieName, Value: "stale"})
+		if _, err := executor.Do(req); err != nil {
+			t.Fatal(err)
+		}
+		session.pending.Wait()
+	}
+
+	if got := strings.Join(recorder.members, ","); got != "web-a,web-b" {
+		t.Fatalf("expected the second request to be pinned to web-b after web-a failed, got %s", got)
+	}
+}
+
+func TestStickyExecutorRepinsOncePerMemberOnRepeatedFailures(t *testing.T) {
+	discover, discoveries := countingDiscovery("web-a", "web-b")
+	session, err := newStickySession(context.Background(), discover)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &memberRecordingExecutor{status: http.StatusServiceUnavailable}
+	executor := &stickyExecutor{HTTPExecutor: recorder, session: session}
+	for i := 0; i < 10; i++ {
+		sendPinned(t, executor)
+	}
+
+	// web-a fails and web-b is pinned, then web-b fails and web-a, which failed moments ago, is not pinned again.
+	if got := discoveries(); got != 3 {
+		t.Fatalf("expected one discovery at configure and one for each failed member, got %d", got-1)
+	}
+	if got := strings.Join(recorder.members, ","); got != "web-a"+strings.Repeat(",web-b", 9) {
+		t.Fatalf("expected requests to stay pinned to web-b once web-a failed, got %s", got)
+	}
+}
+
+func TestStickyExecutorDiscoversOnceForConcurrentFailures(t *testing.T) {
+	discover, discoveries := countingDiscovery("web-a", "web-b")
+	session, err := newStickySession(context.Background(), discover)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &memberRecordingExecutor{status: http.StatusOK, memberStatus: map[string]int{"web-a": http.StatusBadGateway}}
+	executor := &stickyExecutor{HTTPExecutor: recorder, session: session}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://example.jamfcloud.com/api/v1/categories", nil)
+			executor.Do(req)
+		}()
+	}
+	wg.Wait()
+	session.pending.Wait()
+
+	if got := discoveries(); got != 2 {
+		t.Fatalf("expected the concurrent failures of web-a to discover the members once, got %d discoveries", got-1)
+	}
+	if pinned := session.pinned(); pinned != "web-b" {
+		t.Fatalf("expected web-b to be pinned, got %s", pinned)
+	}
+}
+
+func TestStickyExecutorKeepsPinOnRetryAfter(t *testing.T) {
+	discover, discoveries := countingDiscovery("web-a", "web-b")
+	session, err := newStickySession(context.Background(), discover)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &memberRecordingExecutor{status: http.StatusServiceUnavailable, header: http.Header{"Retry-After": []string{"1"}}}
+	executor := &stickyExecutor{HTTPExecutor: recorder, session: session}
+	for i := 0; i < 3; i++ {
+		sendPinned(t, executor)
+	}
+
+	if got := discoveries(); got != 1 {
+		t.Fatalf("expected a 503 with Retry-After not to discover the members again, got %d discoveries", got-1)
+	}
+	if pinned := session.pinned(); pinned != "web-a" {
+		t.Fatalf("expected web-a to stay pinned, got %s", pinned)
+	}
+}
+
+func TestProviderLoadBalancerLockFollowsCycledMember(t *testing.T) {
+	server := mockjamfpro.New()
+	server.SetMembers("web-b", "web-a")
+
+	config := mockProviderConfig(server)
+	config["jamfpro_load_balancer_lock"] = true
+
+	provider := newMockProvider(server)
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("failed to configure provider with jamfpro_load_balancer_lock: %v", diags)
+	}
+	client := provider.Meta().(*jamfpro.Client)
+
+	// Discovery spreads its requests over both members, everything after it is pinned to web-a.
+	discovered := server.MemberRequestCount("web-b")
+	if _, err := client.GetCategories(""); err != nil {
+		t.Fatal(err)
+	}
+	if server.MemberRequestCount("web-b") != discovered {
+		t.Fatal("expected requests to be pinned to web-a, the first member in sort order")
+	}
+
+	// web-a is cycled out. The load balancer hands the next request to web-b, which is pinned from then on.
+	server.SetMembers("web-b", "web-c")
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetCategories(""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if server.MemberRequestCount("web-c") != 0 {
+		t.Fatal("expected requests to stay pinned to web-b after web-a was cycled out")
+	}
+}
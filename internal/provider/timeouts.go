@@ -1,47 +1,109 @@
 package provider
 
-import "time"
-
-const (
-	DefaultContextTimeoutCreate = 65 * time.Second
-	DefaultContextTimeoutRead   = 65 * time.Second
-	DefaultContextTimeoutUpdate = 65 * time.Second
-	DefaultContextTimeoutDelete = 65 * time.Second
-
-	LoadBalancedContextTimeoutCreate = 15 * time.Second
-	LoadBalancedContextTimeoutRead   = 10 * time.Second
-	LoadBalancedContextTimeoutUpdate = 15 * time.Second
-	LoadBalancedContextTimeoutDelete = 10 * time.Second
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// TODO func comment
-func GetDefaultContextTimeoutCreate(load_balancer_lock_enabled bool) time.Duration {
-	if load_balancer_lock_enabled {
-		return LoadBalancedContextTimeoutCreate
+// timeoutKeys are the operations a default_timeouts block may set, as named in a resource timeouts block.
+var timeoutKeys = []string{schema.TimeoutCreate, schema.TimeoutRead, schema.TimeoutUpdate, schema.TimeoutDelete}
+
+// minimumTimeouts are the shortest defaults resources and data sources are given for each operation. Many declare
+// timeouts of a few seconds, which a healthy but busy Jamf Pro instance regularly exceeds.
+var minimumTimeouts = map[string]time.Duration{
+	schema.TimeoutCreate: 2 * time.Minute,
+	schema.TimeoutRead:   1 * time.Minute,
+	schema.TimeoutUpdate: 2 * time.Minute,
+	schema.TimeoutDelete: 1 * time.Minute,
+}
+
+// defaultTimeoutsSchema returns the schema of the provider default_timeouts block.
+func defaultTimeoutsSchema() *schema.Schema {
+	attributes := make(map[string]*schema.Schema, len(timeoutKeys))
+	for _, key := range timeoutKeys {
+		attributes[key] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTimeout,
+			Description:  fmt.Sprintf("The %s timeout of every resource which does not set one in its own timeouts block and does not declare a longer default, e.g. \"5m\".", key),
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Timeouts raising the defaults of each resource and data source, which are at least 2m for create and update and 1m for read and delete. Resources declaring a longer default keep it. A timeouts block within a resource still takes precedence.",
+		Elem:        &schema.Resource{Schema: attributes},
 	}
-	return DefaultContextTimeoutCreate
 }
 
-// TODO func comment
-func GetDefaultContextTimeoutRead(load_balancer_lock_enabled bool) time.Duration {
-	if load_balancer_lock_enabled {
-		return LoadBalancedContextTimeoutRead
+// validateTimeout checks that a timeout is a positive Go duration, e.g. "90s" or "10m".
+func validateTimeout(v interface{}, k string) ([]string, []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as \"90s\" or \"10m\": %v", k, err)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("%q must be greater than zero, got %q", k, v)}
 	}
-	return DefaultContextTimeoutRead
+	return nil, nil
 }
 
-// TODO func comment
-func GetDefaultContextTimeoutUpdate(load_balancer_lock_enabled bool) time.Duration {
-	if load_balancer_lock_enabled {
-		return LoadBalancedContextTimeoutUpdate
+// applyDefaultTimeouts raises the default timeouts declared by resources and data sources to those of the provider
+// default_timeouts block, or to minimumTimeouts for operations the block does not set. A resource declaring a longer
+// default than the provider, such as the 45m jamfpro_package needs to upload large files, keeps its own. Only
+// operations a resource declares a timeout for are changed. Terraform still takes any timeouts block set within a
+// resource over these defaults.
+func applyDefaultTimeouts(d *schema.ResourceData, resourceMaps ...map[string]*schema.Resource) {
+	overrides := make(map[string]time.Duration)
+	if blocks := d.Get("default_timeouts").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		for key, value := range blocks[0].(map[string]interface{}) {
+			if value.(string) == "" {
+				continue
+			}
+			// Validated by validateTimeout.
+			duration, _ := time.ParseDuration(value.(string))
+			overrides[key] = duration
+		}
+	}
+
+	for _, resources := range resourceMaps {
+		for _, r := range resources {
+			if r.Timeouts == nil {
+				continue
+			}
+
+			for _, key := range timeoutKeys {
+				timeout := timeoutOf(r.Timeouts, key)
+				if timeout == nil {
+					continue
+				}
+				raised, ok := overrides[key]
+				if !ok {
+					raised = minimumTimeouts[key]
+				}
+				if *timeout < raised {
+					*timeout = raised
+				}
+			}
+		}
 	}
-	return DefaultContextTimeoutUpdate
 }
 
-// TODO func comment
-func GetDefaultContextTimeoutDelete(load_balancer_lock_enabled bool) time.Duration {
-	if load_balancer_lock_enabled {
-		return LoadBalancedContextTimeoutDelete
+// timeoutOf returns the default timeout declared for an operation, nil if there is none.
+func timeoutOf(timeouts *schema.ResourceTimeout, key string) *time.Duration {
+	switch key {
+	case schema.TimeoutCreate:
+		return timeouts.Create
+	case schema.TimeoutRead:
+		return timeouts.Read
+	case schema.TimeoutUpdate:
+		return timeouts.Update
+	case schema.TimeoutDelete:
+		return timeouts.Delete
 	}
-	return DefaultContextTimeoutDelete
+	return nil
 }
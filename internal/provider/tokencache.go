@@ -0,0 +1,441 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+)
+
+const (
+	envVarTokenCacheDir = "JAMFPRO_TOKEN_CACHE_DIR"
+	oAuthTokenPath      = "/api/oauth/token"
+	bearerTokenPath     = "/api/v1/auth/token"
+	invalidateTokenPath = "/api/v1/auth/invalidate-token"
+	// tokenCacheMargin is how much longer than the token refresh buffer period a cached token must remain valid to
+	// be handed out, so it is not refreshed again as soon as it is received.
+	tokenCacheMargin = time.Minute
+	// tokenLockPollInterval is how often a token cache file locked by another process is checked for being released.
+	tokenLockPollInterval = 50 * time.Millisecond
+	// tokenLockRefreshInterval is how often a held lock file is touched, and tokenLockStaleAge how long after its last
+	// touch it is taken over, as the process holding it died.
+	tokenLockRefreshInterval = 5 * time.Second
+	tokenLockStaleAge        = 30 * time.Second
+)
+
+// sharedTokenCache is the token cache of every provider instance of the process. Terraform runs each provider alias
+// in a process of its own, so tokens only reach other aliases and later runs through the token cache directory.
+var sharedTokenCache = newTokenCache()
+
+// defaultTokenCacheDir returns the token cache directory used unless token_cache_dir is set: JAMFPRO_TOKEN_CACHE_DIR,
+// else terraform-provider-jamfpro/tokens within the cache directory of the user. It is empty, caching tokens in
+// memory only, if the user has no cache directory.
+func defaultTokenCacheDir() (interface{}, error) {
+	if dir := os.Getenv(envVarTokenCacheDir); dir != "" {
+		return dir, nil
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		logging.Warnf(context.Background(), logging.SubsystemHTTP, "Caching Jamf Pro tokens in memory only: %v", err)
+		return "", nil
+	}
+	return filepath.Join(cacheDir, "terraform-provider-jamfpro", "tokens"), nil
+}
+
+// cachedToken is an access token and its expiry, as kept in memory and in the files of the token cache directory.
+type cachedToken struct {
+	Token   string    `json:"token"`
+	Expires time.Time `json:"expires"`
+}
+
+// validFor reports whether the token remains valid for at least lifetime.
+func (t cachedToken) validFor(lifetime time.Duration) bool {
+	return t.Token != "" && time.Until(t.Expires) > lifetime
+}
+
+// tokenCache holds access tokens keyed by tokenCacheKey.
+type tokenCache struct {
+	mu      sync.Mutex
+	entries map[string]*tokenCacheEntry
+}
+
+// tokenCacheEntry holds the token of one key. Its lock is held while a token is requested, so provider instances
+// needing a token at once wait for a single request.
+type tokenCacheEntry struct {
+	mu    sync.Mutex
+	token cachedToken
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{entries: make(map[string]*tokenCacheEntry)}
+}
+
+// entry returns the entry of a key, creating it if needed.
+func (c *tokenCache) entry(key string) *tokenCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &tokenCacheEntry{}
+		c.entries[key] = entry
+	}
+	return entry
+}
+
+// tokenCacheKey identifies the tokens of a client of a Jamf Pro instance. The secret is part of it, so tokens
+// issued before a secret was rotated are not reused, but only as a hash, as the key names the cache file.
+func tokenCacheKey(fqdn, authMethod, principal, secret string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{strings.TrimSuffix(fqdn, "/"), authMethod, principal, secret}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+/*
+tokenCachingExecutor wraps an HTTPExecutor, answering the token requests of the Jamf Pro integration with a cached
+token while it remains valid for longer than the token refresh buffer period. Tokens are looked up in memory, then
+in dir if set, and new tokens are stored in both. The cache file is locked while a token is looked up and
+requested, so provider processes needing a token at once wait for a single request rather than each sending one.
+
+Requests rejected with a 401 response drop the token they carried from the cache.
+*/
+type tokenCachingExecutor struct {
+	httpclient.HTTPExecutor
+	cache *tokenCache
+	key   string
+	// dir is the directory the tokens are also stored in, none if empty.
+	dir string
+	// minLifetime is the lifetime a cached token must have left to be handed out.
+	minLifetime time.Duration
+	// ctx is the provider context the executor logs with.
+	ctx context.Context
+}
+
+// Do answers token requests from the cache if possible and sends every other request.
+func (e *tokenCachingExecutor) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || (req.URL.Path != oAuthTokenPath && req.URL.Path != bearerTokenPath) {
+		resp, err := e.HTTPExecutor.Do(req)
+		if err == nil && resp.StatusCode == http.StatusUnauthorized {
+			e.drop(req.Context(), bearerTokenOf(req))
+		}
+		return resp, err
+	}
+
+	entry := e.cache.entry(e.key)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	unlock, err := e.lockFile(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if !entry.token.validFor(e.minLifetime) {
+		if token, ok := e.readFile(); ok {
+			entry.token = token
+		}
+	}
+	if entry.token.validFor(e.minLifetime) {
+		logging.Debugf(e.ctx, logging.SubsystemHTTP, "Reusing cached Jamf Pro token valid until %s", entry.token.Expires.Format(time.RFC3339))
+		return tokenResponse(req, entry.token)
+	}
+
+	resp, err := e.HTTPExecutor.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	token, err := parseTokenResponse(req.URL.Path, body)
+	if err != nil {
+		logging.Warnf(e.ctx, logging.SubsystemHTTP, "Not caching the Jamf Pro token: %v", err)
+		return resp, nil
+	}
+
+	entry.token = token
+	e.writeFile(token)
+
+	return resp, nil
+}
+
+// drop removes token from the cache if it is the cached token.
+func (e *tokenCachingExecutor) drop(ctx context.Context, token string) {
+	if token == "" {
+		return
+	}
+
+	entry := e.cache.entry(e.key)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	unlock, err := e.lockFile(ctx)
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	if entry.token.Token == token {
+		entry.token = cachedToken{}
+	}
+	if cached, ok := e.readFile(); ok && cached.Token == token {
+		e.removeFile()
+	}
+}
+
+// invalidate removes the cached token from the cache and revokes it on Jamf Pro through executor.
+func (e *tokenCachingExecutor) invalidate(executor httpclient.HTTPExecutor, fqdn string) error {
+	entry := e.cache.entry(e.key)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	unlock, err := e.lockFile(e.ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	token := entry.token
+	if cached, ok := e.readFile(); ok {
+		token = cached
+	}
+	entry.token = cachedToken{}
+	e.removeFile()
+
+	if !token.validFor(0) {
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(fqdn, "/")+invalidateTokenPath, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	resp, err := executor.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate the Jamf Pro token: %v", err)
+	}
+	resp.Body.Close()
+
+	// A token Jamf Pro rejects is invalid already.
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusUnauthorized {
+		return fmt.Errorf("failed to invalidate the Jamf Pro token: %s", resp.Status)
+	}
+
+	logging.Infof(e.ctx, logging.SubsystemHTTP, "Invalidated the cached Jamf Pro token of %s", fqdn)
+	return nil
+}
+
+// path returns the path of the cache file, empty if there is no cache directory.
+func (e *tokenCachingExecutor) path() string {
+	if e.dir == "" {
+		return ""
+	}
+	return filepath.Join(e.dir, e.key+".json")
+}
+
+// lockFile locks the cache file against other processes, waiting for any of them holding it, and returns the
+// function releasing the lock. The lock file is touched while held, so the lock of a process which died is taken over.
+// There is nothing to lock without a cache directory, and a directory which cannot be written to is used unlocked,
+// as writing the token then fails too. Waiting stops with an error once ctx is done.
+func (e *tokenCachingExecutor) lockFile(ctx context.Context) (func(), error) {
+	path := e.path()
+	if path == "" {
+		return func() {}, nil
+	}
+	if err := os.MkdirAll(e.dir, 0o700); err != nil {
+		logging.Warnf(e.ctx, logging.SubsystemHTTP, "Failed to create the token cache directory: %v", err)
+		return func() {}, nil
+	}
+
+	lockPath := path + ".lock"
+	for {
+		lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			lockFile.Close()
+			break
+		}
+		if !os.IsExist(err) {
+			logging.Warnf(e.ctx, logging.SubsystemHTTP, "Failed to lock the token cache: %v", err)
+			return func() {}, nil
+		}
+
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > tokenLockStaleAge {
+			logging.Warnf(e.ctx, logging.SubsystemHTTP, "Taking over the stale lock of the token cache %s", path)
+			os.Remove(lockPath)
+			continue
+		}
+
+		timer := time.NewTimer(tokenLockPollInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("stopped waiting for the token cache %s: %v", path, ctx.Err())
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(tokenLockRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				os.Chtimes(lockPath, now, now)
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		os.Remove(lockPath)
+	}, nil
+}
+
+// readFile reads the token of the cache file, if any.
+func (e *tokenCachingExecutor) readFile() (cachedToken, bool) {
+	path := e.path()
+	if path == "" {
+		return cachedToken{}, false
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return cachedToken{}, false
+	}
+
+	var token cachedToken
+	if err := json.Unmarshal(content, &token); err != nil {
+		return cachedToken{}, false
+	}
+	return token, true
+}
+
+// writeFile stores a token in the cache file, readable by the user only. The file is replaced as a whole so
+// concurrent runs never read a partial token.
+func (e *tokenCachingExecutor) writeFile(token cachedToken) {
+	path := e.path()
+	if path == "" {
+		return
+	}
+
+	content, err := json.Marshal(token)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(e.dir, 0o700); err != nil {
+		logging.Warnf(e.ctx, logging.SubsystemHTTP, "Failed to create the token cache directory: %v", err)
+		return
+	}
+
+	file, err := os.CreateTemp(e.dir, e.key+".*.tmp")
+	if err != nil {
+		logging.Warnf(e.ctx, logging.SubsystemHTTP, "Failed to write the token cache: %v", err)
+		return
+	}
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		logging.Warnf(e.ctx, logging.SubsystemHTTP, "Failed to write the token cache: %v", err)
+	}
+}
+
+// removeFile removes the cache file, if any.
+func (e *tokenCachingExecutor) removeFile() {
+	if path := e.path(); path != "" {
+		os.Remove(path)
+	}
+}
+
+// parseTokenResponse reads the token of a response of the OAuth or the bearer token endpoint.
+func parseTokenResponse(path string, body []byte) (cachedToken, error) {
+	if path == oAuthTokenPath {
+		var response struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int64  `json:"expires_in"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return cachedToken{}, err
+		}
+		if response.AccessToken == "" {
+			return cachedToken{}, fmt.Errorf("the response holds no access token")
+		}
+		return cachedToken{Token: response.AccessToken, Expires: time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)}, nil
+	}
+
+	var response cachedToken
+	if err := json.Unmarshal(body, &response); err != nil {
+		return cachedToken{}, err
+	}
+	if response.Token == "" {
+		return cachedToken{}, fmt.Errorf("the response holds no token")
+	}
+	return response, nil
+}
+
+// tokenResponse returns a response of the token endpoint requested by req, carrying a cached token.
+func tokenResponse(req *http.Request, token cachedToken) (*http.Response, error) {
+	var body interface{} = token
+	if req.URL.Path == oAuthTokenPath {
+		body = map[string]interface{}{
+			"access_token": token.Token,
+			"token_type":   "Bearer",
+			"expires_in":   int64(time.Until(token.Expires).Seconds()),
+		}
+	}
+
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       req,
+	}, nil
+}
+
+// bearerTokenOf returns the bearer token a request carries, if any.
+func bearerTokenOf(req *http.Request) string {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return token
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/tokencache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// configureWithTokenCache configures a provider against the mock Jamf Pro which shares tokens through cache.
+func configureWithTokenCache(t *testing.T, server *mockjamfpro.Server, cache *tokenCache, config map[string]interface{}) *jamfpro.Client {
+	t.Helper()
+
+	provider := newProvider(func(*http.Transport) httpclient.HTTPExecutor { return server.Executor() }, cache)
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+	return provider.Meta().(*jamfpro.Client)
+}
+
+// useTokenCacheHome points the cache directory of the user at a new temporary directory and returns the default
+// token cache directory within it.
+func useTokenCacheHome(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv(envVarTokenCacheDir, "")
+	t.Setenv("XDG_CACHE_HOME", home)
+	t.Setenv("HOME", home)
+	t.Setenv("LocalAppData", home)
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(cacheDir, "terraform-provider-jamfpro", "tokens")
+}
+
+func TestProviderAliasesShareTokens(t *testing.T) {
+	server := mockjamfpro.New()
+	dir := useTokenCacheHome(t)
+
+	basicAuth := map[string]interface{}{
+		"jamfpro_instance_fqdn":                mockjamfpro.DefaultFQDN,
+		"auth_method":                          "basic",
+		"basic_auth_username":                  server.Username,
+		"basic_auth_password":                  server.Password,
+		"mandatory_request_delay_milliseconds": 0,
+	}
+	oauth := mockProviderConfig(server)
+	delete(oauth, "token_cache_dir")
+
+	// Terraform runs each alias in a process of its own, so each starts with an empty cache in memory.
+	for _, config := range []map[string]interface{}{oauth, oauth, basicAuth} {
+		client := configureWithTokenCache(t, server, newTokenCache(), config)
+		if _, err := client.GetCategories(""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := server.RequestCount("POST /api/oauth/token"); got != 1 {
+		t.Fatalf("expected both OAuth aliases to share one token, %d were requested", got)
+	}
+	if got := server.RequestCount("POST /api/v1/auth/token"); got != 1 {
+		t.Fatalf("expected the basic auth alias to request its own token, %d were requested", got)
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatalf("expected the tokens to be cached in %s: %v", dir, err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0o700 {
+		t.Fatalf("expected the token cache directory to be accessible by the user only, its mode is %s", info.Mode())
+	}
+}
+
+func TestProviderTokenCacheDir(t *testing.T) {
+	server := mockjamfpro.New()
+	config := mockProviderConfig(server)
+	config["token_cache_dir"] = t.TempDir()
+
+	// Each run of Terraform starts with an empty cache in memory.
+	for i := 0; i < 2; i++ {
+		client := configureWithTokenCache(t, server, newTokenCache(), config)
+		if _, err := client.GetCategories(""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := server.RequestCount("POST /api/oauth/token"); got != 1 {
+		t.Fatalf("expected the second run to reuse the token of the first, %d were requested", got)
+	}
+
+	files, err := filepath.Glob(filepath.Join(config["token_cache_dir"].(string), "*.json"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected one token cache file, found %v: %v", files, err)
+	}
+	info, err := os.Stat(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected the token cache file to be readable by the user only, its mode is %s", info.Mode())
+	}
+}
+
+func TestTokenCacheInvalidate(t *testing.T) {
+	server := mockjamfpro.New()
+	config := mockProviderConfig(server)
+	config["token_cache_dir"] = t.TempDir()
+	cache := newTokenCache()
+
+	client := configureWithTokenCache(t, server, cache, config)
+	if err := tokencache.Invalidate(client); err != nil {
+		t.Fatal(err)
+	}
+
+	if server.RequestCount("POST /api/v1/auth/invalidate-token") != 1 {
+		t.Fatal("expected the cached token to be revoked on Jamf Pro")
+	}
+	if _, err := client.GetCategories(""); err == nil {
+		t.Fatal("expected the revoked token to be rejected")
+	}
+	if files, _ := filepath.Glob(filepath.Join(config["token_cache_dir"].(string), "*.json")); len(files) != 0 {
+		t.Fatalf("expected the token cache file to be removed, found %v", files)
+	}
+
+	configureWithTokenCache(t, server, cache, config)
+	if got := server.RequestCount("POST /api/oauth/token"); got != 2 {
+		t.Fatalf("expected a new token to be requested after the cached one was invalidated, %d were requested", got)
+	}
+}
+
+// slowTokenExecutor issues a new OAuth token for every request after a delay, counting the requests.
+type slowTokenExecutor struct {
+	httpclient.HTTPExecutor
+	requests atomic.Int32
+}
+
+func (e *slowTokenExecutor) Do(req *http.Request) (*http.Response, error) {
+	n := e.requests.Add(1)
+	time.Sleep(100 * time.Millisecond)
+
+	body := fmt.Sprintf(`{"access_token":"token-%d","token_type":"Bearer","expires_in":1200}`, n)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+}
+
+func TestTokenCacheSharedAcrossProcesses(t *testing.T) {
+	upstream := &slowTokenExecutor{}
+	dir := t.TempDir()
+	key := tokenCacheKey(mockjamfpro.DefaultFQDN, "oauth2", "client", "secret")
+
+	// Each executor has a cache in memory of its own, as provider processes do, and only the directory in common.
+	tokens := make([]string, 2)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range tokens {
+		executor := &tokenCachingExecutor{HTTPExecutor: upstream, cache: newTokenCache(), key: key, dir: dir, ctx: context.Background()}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			req, _ := http.NewRequest(http.MethodPost, mockjamfpro.DefaultFQDN+oAuthTokenPath, nil)
+			resp, err := executor.Do(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer resp.Body.Close()
+
+			var token struct {
+				AccessToken string `json:"access_token"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+				t.Error(err)
+			}
+			tokens[i] = token.AccessToken
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	if got := upstream.requests.Load(); got != 1 {
+		t.Fatalf("expected executors sharing a token cache directory to request one token, %d were requested", got)
+	}
+	if tokens[0] != tokens[1] {
+		t.Fatalf("expected both executors to hand out the same token, got %q and %q", tokens[0], tokens[1])
+	}
+	if locks, _ := filepath.Glob(filepath.Join(dir, "*.lock")); len(locks) != 0 {
+		t.Fatalf("expected the lock to be released, found %v", locks)
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	envVarProxyURL     = "JAMFPRO_PROXY_URL"
+	envVarCABundleFile = "JAMFPRO_CA_BUNDLE_FILE"
+)
+
+// transportConfig holds the network settings of the connection to Jamf Pro.
+type transportConfig struct {
+	// proxyURL is the proxy requests are sent through. The proxy environment variables are used if empty.
+	proxyURL string
+	// caBundles are PEM encoded certificate authorities trusted besides those of the system.
+	caBundles [][]byte
+	// clientCertificate and clientKey are the PEM encoded certificate and key presented to Jamf Pro, if any.
+	clientCertificate []byte
+	clientKey         []byte
+	// insecureSkipVerify disables the verification of the certificate of Jamf Pro.
+	insecureSkipVerify bool
+}
+
+// transportConfigFrom reads the network settings from the provider configuration, loading the files it names.
+func transportConfigFrom(d *schema.ResourceData) (transportConfig, diag.Diagnostics) {
+	config := transportConfig{
+		proxyURL:           d.Get("proxy_url").(string),
+		insecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}
+
+	if path := d.Get("ca_bundle_file").(string); path != "" {
+		bundle, err := os.ReadFile(path)
+		if err != nil {
+			return config, diag.Errorf("failed to read ca_bundle_file %s: %v", path, err)
+		}
+		config.caBundles = append(config.caBundles, bundle)
+	}
+	if bundle := d.Get("ca_bundle_pem").(string); bundle != "" {
+		config.caBundles = append(config.caBundles, []byte(bundle))
+	}
+
+	var err error
+	if config.clientCertificate, err = pemOrFile(d.Get("client_certificate").(string)); err != nil {
+		return config, diag.Errorf("failed to read client_certificate: %v", err)
+	}
+	if config.clientKey, err = pemOrFile(d.Get("client_key").(string)); err != nil {
+		return config, diag.Errorf("failed to read client_key: %v", err)
+	}
+
+	return config, nil
+}
+
+// pemOrFile returns value if it is PEM encoded, and the contents of the file it names otherwise.
+func pemOrFile(value string) ([]byte, error) {
+	if value == "" || strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// newTransport returns an HTTP transport with the defaults of net/http and the given network settings.
+func newTransport(config transportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.proxyURL != "" {
+		proxy, err := url.Parse(config.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %v", err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
+
+	if len(config.caBundles) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		for _, bundle := range config.caBundles {
+			if !pool.AppendCertsFromPEM(bundle) {
+				return nil, fmt.Errorf("the CA bundle holds no PEM encoded certificates")
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case len(config.clientCertificate) > 0 && len(config.clientKey) > 0:
+		certificate, err := tls.X509KeyPair(config.clientCertificate, config.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client_certificate or client_key: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	case len(config.clientCertificate) > 0 || len(config.clientKey) > 0:
+		return nil, fmt.Errorf("client_certificate and client_key must be set together")
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newClientCertificate returns a self-signed client certificate and its key, PEM encoded.
+func newClientCertificate(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// get sends a GET request to url through transport.
+func get(transport *http.Transport, url string) error {
+	resp, err := (&http.Client{Transport: transport}).Get(url)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func TestNewTransportTrustsCABundleAndPresentsClientCertificate(t *testing.T) {
+	certPEM, keyPEM := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(certPEM)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	untrusted, err := newTransport(transportConfig{clientCertificate: certPEM, clientKey: keyPEM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := get(untrusted, server.URL); err == nil {
+		t.Fatal("expected the certificate of the server to be rejected without the CA bundle")
+	}
+
+	withoutCertificate, err := newTransport(transportConfig{caBundles: [][]byte{serverCA}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := get(withoutCertificate, server.URL); err == nil {
+		t.Fatal("expected the server to reject a connection without a client certificate")
+	}
+
+	transport, err := newTransport(transportConfig{caBundles: [][]byte{serverCA}, clientCertificate: certPEM, clientKey: keyPEM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := get(transport, server.URL); err != nil {
+		t.Fatalf("expected the request to succeed with the CA bundle and client certificate: %v", err)
+	}
+}
+
+func TestNewTransportInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	transport, err := newTransport(transportConfig{insecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := get(transport, server.URL); err != nil {
+		t.Fatalf("expected the certificate of the server not to be verified: %v", err)
+	}
+}
+
+func TestNewTransportProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	transport, err := newTransport(transportConfig{proxyURL: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := get(transport, "http://jamf.example.invalid/api/v1/jamf-pro-version"); err != nil {
+		t.Fatal(err)
+	}
+	if proxied != "http://jamf.example.invalid/api/v1/jamf-pro-version" {
+		t.Fatalf("expected the request to be sent through the proxy, it received %q", proxied)
+	}
+}
+
+func TestNewTransportRejectsInvalidSettings(t *testing.T) {
+	certPEM, _ := newClientCertificate(t)
+
+	for name, config := range map[string]transportConfig{
+		"empty CA bundle":         {caBundles: [][]byte{[]byte("not a certificate")}},
+		"certificate without key": {clientCertificate: certPEM},
+		"mismatched key":          {clientCertificate: certPEM, clientKey: []byte("not a key")},
+	} {
+		if _, err := newTransport(config); err == nil {
+			t.Errorf("expected %s to be rejected", name)
+		}
+	}
+}
+
+func TestProviderInsecureSkipVerifyWarns(t *testing.T) {
+	server := mockjamfpro.New()
+	config := mockProviderConfig(server)
+	config["insecure_skip_verify"] = true
+
+	diags := newMockProvider(server).Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		t.Fatalf("failed to configure provider with insecure_skip_verify: %v", diags)
+	}
+	for _, d := range diags {
+		if d.Severity == diag.Warning && d.Summary == "TLS certificate verification is disabled" {
+			return
+		}
+	}
+	t.Fatalf("expected a warning that TLS certificate verification is disabled, got %v", diags)
+}
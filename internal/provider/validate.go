@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfversion"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// resourcePrivileges lists the API role privileges each resource needs to manage its objects. Only these resources
+// may be named in validate_privileges_for.
+var resourcePrivileges = map[string][]string{
+	"jamfpro_account":                                     crudPrivileges("Accounts"),
+	"jamfpro_account_group":                               crudPrivileges("Accounts"),
+	"jamfpro_activation_code":                             {"Read Activation Code", "Update Activation Code"},
+	"jamfpro_advanced_computer_search":                    crudPrivileges("Advanced Computer Searches"),
+	"jamfpro_advanced_mobile_device_search":               crudPrivileges("Advanced Mobile Device Searches"),
+	"jamfpro_advanced_user_search":                        crudPrivileges("Advanced User Searches"),
+	"jamfpro_allowed_file_extension":                      {"Create Allowed File Extension", "Read Allowed File Extension", "Delete Allowed File Extension"},
+	"jamfpro_api_integration":                             crudPrivileges("API Integrations"),
+	"jamfpro_api_integration_client_credentials":          {"Read API Integrations", "Update API Integrations"},
+	"jamfpro_api_role":                                    crudPrivileges("API Roles"),
+	"jamfpro_building":                                    crudPrivileges("Buildings"),
+	"jamfpro_category":                                    crudPrivileges("Categories"),
+	"jamfpro_computer_checkin":                            {"Read Computer Check-In", "Update Computer Check-In"},
+	"jamfpro_computer_extension_attribute":                crudPrivileges("Computer Extension Attributes"),
+	"jamfpro_computer_inventory_collection":               {"Read Computer Inventory Collection", "Update Computer Inventory Collection"},
+	"jamfpro_computer_prestage_enrollment":                crudPrivileges("Computer PreStage Enrollments"),
+	"jamfpro_computer_prestage_enrollment_scope":          {"Read Computer PreStage Enrollments", "Update Computer PreStage Enrollments"},
+	"jamfpro_department":                                  crudPrivileges("Departments"),
+	"jamfpro_disk_encryption_configuration":               crudPrivileges("Disk Encryption Configurations"),
+	"jamfpro_dock_item":                                   crudPrivileges("Dock Items"),
+	"jamfpro_file_share_distribution_point":               crudPrivileges("Distribution Points"),
+	"jamfpro_macos_configuration_profile_plist":           crudPrivileges("macOS Configuration Profiles"),
+	"jamfpro_macos_configuration_profile_plist_generator": crudPrivileges("macOS Configuration Profiles"),
+	"jamfpro_mobile_device_configuration_profile_plist":   crudPrivileges("iOS Configuration Profiles"),
+	"jamfpro_mobile_device_extension_attribute":           crudPrivileges("Mobile Device Extension Attributes"),
+	"jamfpro_mobile_device_prestage_enrollment":           crudPrivileges("Mobile Device PreStage Enrollments"),
+	"jamfpro_network_segment":                             crudPrivileges("Network Segments"),
+	"jamfpro_package":                                     crudPrivileges("Packages"),
+	"jamfpro_patch_policy":                                crudPrivileges("Patch Policies"),
+	"jamfpro_patch_software_title_configuration":          crudPrivileges("Patch Management Software Titles"),
+	"jamfpro_policy":                                      crudPrivileges("Policies"),
+	"jamfpro_printer":                                     crudPrivileges("Printers"),
+	"jamfpro_restricted_software":                         crudPrivileges("Restricted Software"),
+	"jamfpro_script":                                      crudPrivileges("Scripts"),
+	"jamfpro_site":                                        crudPrivileges("Sites"),
+	"jamfpro_smart_computer_group":                        crudPrivileges("Smart Computer Groups"),
+	"jamfpro_static_computer_group":                       crudPrivileges("Static Computer Groups"),
+	"jamfpro_smart_mobile_device_group":                   crudPrivileges("Smart Mobile Device Groups"),
+	"jamfpro_static_mobile_device_group":                  crudPrivileges("Static Mobile Device Groups"),
+	"jamfpro_user_group":                                  append(crudPrivileges("Smart User Groups"), crudPrivileges("Static User Groups")...),
+	"jamfpro_webhook":                                     crudPrivileges("Webhooks"),
+}
+
+// privilegedResources returns the sorted names of the resources of resourcePrivileges.
+func privilegedResources() []string {
+	names := make([]string, 0, len(resourcePrivileges))
+	for name := range resourcePrivileges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// crudPrivileges returns the create, read, update and delete privileges of an object type.
+func crudPrivileges(objects string) []string {
+	return []string{"Create " + objects, "Read " + objects, "Update " + objects, "Delete " + objects}
+}
+
+// readServerVersion reads the version of Jamf Pro. Succeeding also proves that Jamf Pro answers API requests made
+// with the configured credentials, so bad instance names and credentials fail here, within the first request.
+func readServerVersion(ctx context.Context, client *jamfpro.Client, fqdn string) (jamfversion.Server, diag.Diagnostics) {
+	response, err := client.GetJamfProVersion()
+	if err != nil || response.Version == nil {
+		detail := fmt.Sprintf("Could not read the Jamf Pro version from %s: %v.", fqdn, err)
+		if classified := common.ClassifyError(err); classified != nil {
+			switch classified.StatusCode {
+			case http.StatusUnauthorized:
+				detail += " The credentials were rejected."
+			case http.StatusForbidden:
+				detail += " The credentials lack the privileges to call the Jamf Pro API."
+			case 0:
+				detail += " Check jamfpro_instance_fqdn and that the instance is reachable from this host."
+			}
+		}
+
+		return jamfversion.Server{}, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Jamf Pro connectivity check failed",
+			Detail:   detail,
+		}}
+	}
+
+	version, err := jamfversion.Parse(*response.Version)
+	if err != nil {
+		return jamfversion.Server{}, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unrecognised Jamf Pro version",
+			Detail:   err.Error(),
+		}}
+	}
+
+	logging.Infof(ctx, logging.SubsystemHTTP, "Connected to Jamf Pro %s at %s", *response.Version, fqdn)
+	return jamfversion.Server{Version: version, Reported: *response.Version}, nil
+}
+
+// validatePrivileges compares the privileges granted to an API client through its API roles with those
+// resourcePrivileges lists for the given resources, those of validate_privileges_for. Terraform does not tell the
+// provider which resources a configuration uses, so any shortfall is reported as a single warning naming the
+// affected resources rather than as an error.
+func validatePrivileges(client *jamfpro.Client, clientID string, resources []string) diag.Diagnostics {
+	granted, err := grantedPrivileges(client, clientID)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Jamf Pro API client privileges could not be checked",
+			Detail:   err.Error(),
+		}}
+	}
+
+	var shortfalls []string
+	for _, resource := range resources {
+		var missing []string
+		for _, privilege := range resourcePrivileges[resource] {
+			if !granted[privilege] {
+				missing = append(missing, privilege)
+			}
+		}
+		if len(missing) > 0 {
+			shortfalls = append(shortfalls, fmt.Sprintf("  %s: %s", resource, strings.Join(missing, ", ")))
+		}
+	}
+
+	if len(shortfalls) == 0 {
+		return nil
+	}
+
+	sort.Strings(shortfalls)
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Jamf Pro API client is missing privileges",
+		Detail: fmt.Sprintf("The API roles of client %s do not grant every privilege the following resources need. "+
+			"Resources of these types will fail to apply unless the privileges are added:\n\n%s",
+			clientID, strings.Join(shortfalls, "\n")),
+	}}
+}
+
+// grantedPrivileges returns the privileges of every API role assigned to the API integration of a client ID.
+func grantedPrivileges(client *jamfpro.Client, clientID string) (map[string]bool, error) {
+	integrations, err := client.GetApiIntegrations("")
+	if err != nil {
+		return nil, fmt.Errorf("the API integrations could not be listed, which needs the Read API Integrations privilege: %v", err)
+	}
+
+	var scopes []string
+	found := false
+	for _, integration := range integrations.Results {
+		if integration.ClientID == clientID {
+			scopes = integration.AuthorizationScopes
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no API integration was found for client ID %s", clientID)
+	}
+
+	roles, err := client.GetJamfAPIRoles("")
+	if err != nil {
+		return nil, fmt.Errorf("the API roles could not be listed, which needs the Read API Roles privilege: %v", err)
+	}
+
+	assigned := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		assigned[scope] = true
+	}
+
+	granted := make(map[string]bool)
+	for _, role := range roles.Results {
+		if !assigned[role.DisplayName] {
+			continue
+		}
+		for _, privilege := range role.Privileges {
+			granted[privilege] = true
+		}
+	}
+
+	return granted, nil
+}
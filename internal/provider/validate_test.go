@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// configureValidated configures a provider with validate_on_configure against the given mock Jamf Pro, checking the
+// privileges of the given resources.
+func configureValidated(server *mockjamfpro.Server, resources ...interface{}) diag.Diagnostics {
+	config := mockProviderConfig(server)
+	config["validate_on_configure"] = true
+	if len(resources) > 0 {
+		config["validate_privileges_for"] = resources
+	}
+
+	return newMockProvider(server).Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+}
+
+func TestProviderValidateOnConfigure(t *testing.T) {
+	provider, server := configureMockProvider(t)
+	client := provider.Meta().(*jamfpro.Client)
+
+	privileges := append(crudPrivileges("Categories"), "Read API Integrations", "Read API Roles")
+	if _, err := client.CreateJamfApiRole(&jamfpro.ResourceAPIRole{DisplayName: "terraform", Privileges: privileges}); err != nil {
+		t.Fatal(err)
+	}
+	integration, err := client.CreateApiIntegration(&jamfpro.ResourceApiIntegration{
+		DisplayName:         "terraform",
+		Enabled:             true,
+		AuthorizationScopes: []string{"terraform"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.ClientID = integration.ClientID
+
+	diags := configureValidated(server, "jamfpro_category", "jamfpro_policy")
+	if diags.HasError() {
+		t.Fatalf("failed to configure provider with validate_on_configure: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning about missing privileges, got %v", diags)
+	}
+	if detail := diags[0].Detail; !strings.Contains(detail, "jamfpro_policy: Create Policies") || strings.Contains(detail, "jamfpro_category") || strings.Contains(detail, "jamfpro_script") {
+		t.Fatalf("expected the warning to name jamfpro_policy only, got %q", detail)
+	}
+
+	// Without validate_privileges_for no privileges are checked.
+	if diags := configureValidated(server); len(diags) != 0 {
+		t.Fatalf("expected no privilege check without validate_privileges_for, got %v", diags)
+	}
+}
+
+func TestProviderValidateOnConfigureUnknownClient(t *testing.T) {
+	diags := configureValidated(mockjamfpro.New(), "jamfpro_category")
+	if diags.HasError() {
+		t.Fatalf("expected privileges which cannot be checked not to fail configuring the provider: %v", diags)
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "could not be checked") {
+		t.Fatalf("expected a single warning that privileges could not be checked, got %v", diags)
+	}
+}
+
+func TestProviderValidateOnConfigureBasicAuth(t *testing.T) {
+	server := mockjamfpro.New()
+
+	config := map[string]interface{}{
+		"jamfpro_instance_fqdn":                mockjamfpro.DefaultFQDN,
+		"auth_method":                          "basic",
+		"basic_auth_username":                  server.Username,
+		"basic_auth_password":                  server.Password,
+		"mandatory_request_delay_milliseconds": 0,
+		"token_cache_dir":                      "",
+		"validate_on_configure":                true,
+	}
+
+	diags := newMockProvider(server).Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if len(diags) != 0 {
+		t.Fatalf("expected basic auth to be validated without diagnostics, got %v", diags)
+	}
+	if server.RequestCount("GET /api/v1/jamf-pro-version") != 1 {
+		t.Fatal("expected the Jamf Pro version to be read when validating")
+	}
+}
+
+func TestProviderServerVersion(t *testing.T) {
+	server := mockjamfpro.New()
+	server.Version = "11.6.1-t1718045462"
+
+	provider := newMockProvider(server)
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(mockProviderConfig(server))); diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+
+	// jamfpro_server_info reports the version read when the provider was configured.
+	dataSource := provider.DataSourcesMap["jamfpro_server_info"]
+	d := dataSource.TestResourceData()
+	if diags := dataSource.ReadContext(context.Background(), d, provider.Meta()); diags.HasError() {
+		t.Fatalf("failed to read jamfpro_server_info: %v", diags)
+	}
+	if d.Get("version") != server.Version || d.Get("minor_version") != 6 || d.Get("build") != "t1718045462" {
+		t.Fatalf("unexpected jamfpro_server_info: version %v, minor_version %v, build %v", d.Get("version"), d.Get("minor_version"), d.Get("build"))
+	}
+	if count := server.RequestCount("GET /api/v1/jamf-pro-version"); count != 1 {
+		t.Fatalf("expected the Jamf Pro version to be read once, when configuring, got %d requests", count)
+	}
+}
+
+func TestProviderServerVersionGatesAttributesAtPlan(t *testing.T) {
+	ctx := context.Background()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                    "tf-mock-account-group",
+		"access_level":            "Full Access",
+		"privilege_set":           "Custom",
+		"casper_admin_privileges": []interface{}{"Use Casper Admin"},
+	})
+
+	for version, supported := range map[string]bool{"11.5.0-t1714996541": true, "11.6.1-t1718045462": false} {
+		server := mockjamfpro.New()
+		server.Version = version
+
+		provider := newMockProvider(server)
+		if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(mockProviderConfig(server))); diags.HasError() {
+			t.Fatalf("failed to configure provider: %v", diags)
+		}
+
+		_, err := provider.ResourcesMap["jamfpro_account_group"].Diff(ctx, nil, config, provider.Meta())
+		switch {
+		case supported && err != nil:
+			t.Errorf("Jamf Pro %s: expected casper_admin_privileges to be planned, got %v", version, err)
+		case !supported && (err == nil || !strings.Contains(err.Error(), "casper_admin_privileges is not supported")):
+			t.Errorf("Jamf Pro %s: expected the plan to fail on casper_admin_privileges, got %v", version, err)
+		}
+	}
+}
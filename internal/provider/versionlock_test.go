@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/mockjamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// interleavingExecutor calls after once a request it sends has been answered, so tests can change Jamf Pro between
+// two requests of a resource.
+type interleavingExecutor struct {
+	httpclient.HTTPExecutor
+	after func(req *http.Request)
+}
+
+func (e *interleavingExecutor) Do(req *http.Request) (*http.Response, error) {
+	resp, err := e.HTTPExecutor.Do(req)
+	if err == nil && e.after != nil {
+		e.after(req)
+	}
+	return resp, err
+}
+
+func TestPrestageUpdateAfterConcurrentEdits(t *testing.T) {
+	ctx := context.Background()
+	provider, server := configureMockProvider(t)
+	meta := provider.Meta()
+	client := meta.(*jamfpro.Client)
+	r := provider.ResourcesMap["jamfpro_mobile_device_prestage_enrollment"]
+
+	config := map[string]interface{}{
+		"display_name":                          "tf-mock-mobile-prestage",
+		"device_enrollment_program_instance_id": "1",
+		"assigned_device_serial_numbers":        []interface{}{"DMPXK2AAAAAA"},
+	}
+	state := applyLifecycleStep(ctx, t, r, nil, config, meta, "create")
+
+	// Edits made in Jamf Pro after the last refresh leave the version locks of the state stale.
+	path := "/api/v2/mobile-device-prestages/" + state.ID
+	var prestage map[string]interface{}
+	if _, err := client.HTTP.DoRequest("GET", path, nil, &prestage); err != nil {
+		t.Fatal(err)
+	}
+	prestage["department"] = "Edited in Jamf Pro"
+	if _, err := client.HTTP.DoRequest("PUT", path, prestage, &prestage); err != nil {
+		t.Fatal(err)
+	}
+	var scope map[string]interface{}
+	if _, err := client.HTTP.DoRequest("GET", path+"/scope", nil, &scope); err != nil {
+		t.Fatal(err)
+	}
+	replacement := map[string]interface{}{"serialNumbers": []string{"DMPXK2AAAAAA", "DMPXK2ZZZZZZ"}, "versionLock": scope["versionLock"]}
+	if _, err := client.HTTP.DoRequest("PUT", path+"/scope", replacement, &scope); err != nil {
+		t.Fatal(err)
+	}
+
+	config["support_phone_number"] = "555-0100"
+	config["assigned_device_serial_numbers"] = []interface{}{"DMPXK2BBBBBB"}
+	updated := applyLifecycleStep(ctx, t, r, state, config, meta, "update")
+
+	if updated.Attributes["support_phone_number"] != "555-0100" || updated.Attributes["assigned_device_serial_numbers.#"] != "1" {
+		t.Fatalf("expected the update to apply, got %v", updated.Attributes)
+	}
+	if got := server.RequestCount("PUT " + path); got != 2 {
+		t.Fatalf("expected the update to succeed at its first attempt against the current version lock, %d updates were sent", got)
+	}
+}
+
+func TestComputerPrestageScopeDeltas(t *testing.T) {
+	ctx := context.Background()
+	provider, server := configureMockProvider(t)
+	meta := provider.Meta()
+	client := meta.(*jamfpro.Client)
+	r := provider.ResourcesMap["jamfpro_computer_prestage_enrollment_scope"]
+
+	prestage, err := client.CreateComputerPrestage(&jamfpro.ResourceComputerPrestage{DisplayName: "tf-mock-computer-prestage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := "/api/v2/computer-prestages/" + prestage.ID + "/scope"
+
+	config := map[string]interface{}{
+		"prestage_id":    prestage.ID,
+		"serial_numbers": []interface{}{"C02AAAAAAAAA", "C02BBBBBBBBB", "C02CCCCCCCCC"},
+	}
+	state := applyLifecycleStep(ctx, t, r, nil, config, meta, "create")
+	verifyImport(ctx, t, r, state, nil, meta)
+
+	// A device assigned in Jamf Pro after the last refresh leaves the version lock of the state stale.
+	scope, err := client.GetDeviceScopeForComputerPrestageByID(prestage.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assigned := map[string]interface{}{"serialNumbers": []string{"C02ZZZZZZZZZ"}, "versionLock": scope.VersionLock}
+	if _, err := client.HTTP.DoRequest("POST", path, assigned, scope); err != nil {
+		t.Fatal(err)
+	}
+
+	config["serial_numbers"] = []interface{}{"C02AAAAAAAAA", "C02BBBBBBBBB", "C02DDDDDDDDD"}
+	state = applyLifecycleStep(ctx, t, r, state, config, meta, "update")
+
+	scope, err = client.GetDeviceScopeForComputerPrestageByID(prestage.ID)
+	if err != nil || len(scope.Assignments) != 3 || state.Attributes["serial_numbers.#"] != "3" {
+		t.Fatalf("expected the scope to hold the configured serial numbers only, got %+v, %v", scope, err)
+	}
+	// One request adds the new serial number, another removes the two others, without resending the whole scope.
+	if adds, removals := server.RequestCount("POST "+path), server.RequestCount("POST "+path+"/delete-multiple"); adds != 3 || removals != 1 {
+		t.Fatalf("expected 3 additions and 1 removal, got %d and %d", adds, removals)
+	}
+
+	if _, diags := r.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, meta); diags.HasError() {
+		t.Fatalf("destroy failed: %v", diags)
+	}
+	if scope, err = client.GetDeviceScopeForComputerPrestageByID(prestage.ID); err != nil || len(scope.Assignments) != 0 {
+		t.Fatalf("expected destroy to unassign the devices, got %+v, %v", scope, err)
+	}
+}
+
+func TestComputerPrestageScopeConflictBetweenAddAndRemove(t *testing.T) {
+	ctx := context.Background()
+	server := mockjamfpro.New()
+
+	var interleave func(req *http.Request)
+	provider := newProvider(func(*http.Transport) httpclient.HTTPExecutor {
+		return &interleavingExecutor{HTTPExecutor: server.Executor(), after: func(req *http.Request) {
+			if interleave != nil {
+				interleave(req)
+			}
+		}}
+	}, newTokenCache())
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(mockProviderConfig(server))); diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+	meta := provider.Meta()
+	client := meta.(*jamfpro.Client)
+	r := provider.ResourcesMap["jamfpro_computer_prestage_enrollment_scope"]
+
+	prestage, err := client.CreateComputerPrestage(&jamfpro.ResourceComputerPrestage{DisplayName: "tf-mock-computer-prestage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := "/api/v2/computer-prestages/" + prestage.ID + "/scope"
+
+	config := map[string]interface{}{
+		"prestage_id":    prestage.ID,
+		"serial_numbers": []interface{}{"C02AAAAAAAAA", "C02BBBBBBBBB"},
+	}
+	state := applyLifecycleStep(ctx, t, r, nil, config, meta, "create")
+
+	// Another device is assigned in Jamf Pro once the update has added its serial number, so the removal which
+	// follows carries a stale version lock.
+	interleave = func(req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != path {
+			return
+		}
+		interleave = nil
+
+		scope, err := client.GetDeviceScopeForComputerPrestageByID(prestage.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assigned := map[string]interface{}{"serialNumbers": []string{"C02ZZZZZZZZZ"}, "versionLock": scope.VersionLock}
+		if _, err := client.HTTP.DoRequest("POST", path, assigned, scope); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	addsBefore := server.RequestCount("POST " + path)
+	config["serial_numbers"] = []interface{}{"C02AAAAAAAAA", "C02CCCCCCCCC"}
+	applyLifecycleStep(ctx, t, r, state, config, meta, "update")
+
+	scope, err := client.GetDeviceScopeForComputerPrestageByID(prestage.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var assigned []string
+	for _, assignment := range scope.Assignments {
+		assigned = append(assigned, assignment.SerialNumber)
+	}
+	sort.Strings(assigned)
+	if want := []string{"C02AAAAAAAAA", "C02CCCCCCCCC"}; !reflect.DeepEqual(assigned, want) {
+		t.Fatalf("expected the scope to hold %v, got %v", want, assigned)
+	}
+
+	// The serial number added before the conflict is not added again: one add by the resource, one by the
+	// interleaved change, and the removal is retried against the version lock read after the conflict.
+	if adds, removals := server.RequestCount("POST "+path)-addsBefore, server.RequestCount("POST "+path+"/delete-multiple"); adds != 2 || removals != 2 {
+		t.Fatalf("expected 2 additions and 2 removals, got %d and %d", adds, removals)
+	}
+}
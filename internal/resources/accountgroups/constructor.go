@@ -2,17 +2,18 @@
 package accountgroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAccountGroup constructs an AccountGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAccountGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAccountGroup, error) {
 	resource := &jamfpro.ResourceAccountGroup{
 		Name:         d.Get("name").(string),
 		AccessLevel:  d.Get("access_level").(string),
@@ -38,7 +39,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAccountGroup, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Account Group '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Account Group XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Account Group XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
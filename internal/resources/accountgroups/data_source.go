@@ -3,13 +3,12 @@ package accountgroups
 
 import (
 	"context"
-	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
-
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,49 +21,63 @@ func DataSourceJamfProAccountGroups() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the account group.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the account group. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The name of the account group.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the account group. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of specific account group from Jamf Pro using either their unique Name or Id.
+// dataSourceRead fetches the details of a specific account group from Jamf Pro using either its unique name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
 
-	var err error
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
-
-	var resource *jamfpro.ResourceAccountGroup
-
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = client.GetAccountGroupByID(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Account Group",
+		"name",
+		client.GetAccountGroupByID,
+		client.GetAccountGroupByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
 
+// listNames returns the names of all account groups in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetAccounts()
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Account Group with ID '%s' after retries: %v", resourceID, err))
+		return nil, err
 	}
 
-	if resource != nil {
-		d.SetId(resourceID)
-		if err := d.Set("name", resource.Name); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Account Group with ID '%s': %v", resourceID, err))...)
-		}
-	} else {
-		d.SetId("")
+	names := make([]string, 0, len(response.Groups))
+	for _, item := range response.Groups {
+		names = append(names, item.Name)
+	}
+
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the account group returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceAccountGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(strconv.Itoa(resource.ID))
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
@@ -5,13 +5,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfprivileges"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfversion"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// versionedAttributes are the attributes whose support depends on the Jamf Pro version.
+var versionedAttributes = []jamfversion.AttributeGate{jamfprivileges.CasperAdminPrivilegesGate}
+
 // customDiffAccountGroups is a custom diff function for the Jamf Pro Account resource.
 // This function is used during the Terraform plan phase to apply custom validation rules
 // that are not covered by the basic schema validation.
 func customDiffAccountGroups(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := jamfversion.ValidateAttributes(ctx, d, versionedAttributes); err != nil {
+		return err
+	}
+
 	accessLevel := d.Get("access_level").(string)
 
 	if accessLevel == "Site Access" {
@@ -2,13 +2,15 @@
 package accounts
 
 import (
+	"context"
+
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAccount constructs an Account object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAccount, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAccount, error) {
 	resource := &jamfpro.ResourceAccount{
 		Name:                d.Get("name").(string),
 		DirectoryUser:       d.Get("directory_user").(bool),
@@ -5,11 +5,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfprivileges"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfversion"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// versionedAttributes are the attributes whose support depends on the Jamf Pro version.
+var versionedAttributes = []jamfversion.AttributeGate{jamfprivileges.CasperAdminPrivilegesGate}
+
 // customDiffAccounts is the top-level custom diff function.
 func customDiffAccounts(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := jamfversion.ValidateAttributes(ctx, d, versionedAttributes); err != nil {
+		return err
+	}
+
 	if err := validateAccessLevelSiteRequirement(ctx, d, meta); err != nil {
 		return err
 	}
@@ -22,6 +31,11 @@ func customDiffAccounts(ctx context.Context, d *schema.ResourceDiff, meta interf
 		return err
 	}
 
+	// Jamf Pro 11.6 removed Casper Admin, so its privileges are neither required nor checked from then on.
+	if jamfversion.ServerAtLeast(ctx, jamfprivileges.CasperAdminPrivilegesGate.RemovedIn) {
+		return nil
+	}
+
 	if err := validateCasperAdminUsePrivileges(ctx, d, meta); err != nil {
 		return err
 	}
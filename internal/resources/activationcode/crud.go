@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	activationCodeConfig, err := construct(d)
+	activationCodeConfig, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Activation Code for update: %v", err))
 	}
@@ -23,7 +24,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		apiErr := client.UpdateActivationCode(activationCodeConfig)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -49,7 +50,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 		var apiErr error
 		response, apiErr = client.GetActivationCode()
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -66,7 +67,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	activationCodeConfig, err := construct(d)
+	activationCodeConfig, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Activation Code for update: %v", err))
 	}
@@ -74,7 +75,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		apiErr := client.UpdateActivationCode(activationCodeConfig)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
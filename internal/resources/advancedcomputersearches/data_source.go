@@ -3,11 +3,11 @@ package advancedcomputersearches
 
 import (
 	"context"
-	"fmt"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,58 +17,63 @@ func DataSourceJamfProAdvancedComputerSearches() *schema.Resource {
 		ReadContext: dataSourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API integration.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the advanced computer search. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique name of the advanced computer search.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the advanced computer search. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific API integration
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'display_name' attribute over the 'id'
-// attribute for fetching details. If neither 'display_name' nor 'id' is provided, it returns an error.
-// Once the details are fetched, they are set in the data source's state.
-//
-// Parameters:
-// - ctx: The context within which the function is called. It's used for timeouts and cancellation.
-// - d: The current state of the data source.
-// - meta: The meta object that can be used to retrieve the API client connection.
-//
-// Returns:
-// - diag.Diagnostics: Returns any diagnostics (errors or warnings) encountered during the function's execution.
+// dataSourceRead fetches the details of a specific advanced computer search from Jamf Pro using either its unique name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
-
-	var resource *jamfpro.ResourceAdvancedComputerSearch
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = client.GetAdvancedComputerSearchByID(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Advanced Computer Search",
+		"name",
+		client.GetAdvancedComputerSearchByID,
+		client.GetAdvancedComputerSearchByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
 
+// listNames returns the names of all advanced computer searches in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetAdvancedComputerSearches()
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Advanced Computer Search with ID '%s' after retries: %v", resourceID, err))
+		return nil, err
+	}
+
+	names := make([]string, 0, len(response.AdvancedComputerSearches))
+	for _, item := range response.AdvancedComputerSearches {
+		names = append(names, item.Name)
 	}
 
-	if resource != nil {
-		d.SetId(resourceID)
-		if err := d.Set("name", resource.Name); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Advanced Computer Search with ID '%s': %v", resourceID, err))...)
-		}
-	} else {
-		d.SetId("")
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the advanced computer search returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceAdvancedComputerSearch) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(strconv.Itoa(resource.ID))
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
@@ -2,17 +2,18 @@
 package advancedmobiledevicesearches
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAdvancedMobileDeviceSearch constructs a mobile device search object for create and update operations.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedMobileDeviceSearch, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAdvancedMobileDeviceSearch, error) {
 	resource := &jamfpro.ResourceAdvancedMobileDeviceSearch{
 		Name:   d.Get("name").(string),
 		ViewAs: d.Get("view_as").(string),
@@ -53,7 +54,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedMobileDeviceSea
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Advanced Mobile Device Search '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Advanced Mobile Device Search XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Advanced Mobile Device Search XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
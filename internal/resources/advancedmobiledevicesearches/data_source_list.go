@@ -0,0 +1,79 @@
+// advancedmobiledevicesearches_data_source_list.go
+package advancedmobiledevicesearches
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the advanced mobile device searches list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "advanced mobile device search",
+	Site:             true,
+	FilterOnly:       true,
+}
+
+// DataSourceJamfProAdvancedMobileDeviceSearchesList provides the advanced mobile device searches in Jamf Pro, optionally filtered by name or site.
+func DataSourceJamfProAdvancedMobileDeviceSearchesList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the advanced mobile device searches in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		func(item *common.ListItem) error {
+			return describeItem(client, item)
+		},
+	)
+}
+
+// listItems returns all advanced mobile device searches in Jamf Pro as list items.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetAdvancedMobileDeviceSearches()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.AdvancedMobileDeviceSearches))
+	for _, item := range response.AdvancedMobileDeviceSearches {
+		items = append(items, common.ListItem{
+			ID:   strconv.Itoa(item.ID),
+			Name: item.Name,
+		})
+	}
+
+	return items, nil
+}
+
+// describeItem completes a advanced mobile device search list item with its site, which the list endpoint omits.
+func describeItem(client *jamfpro.Client, item *common.ListItem) error {
+	resource, err := client.GetAdvancedMobileDeviceSearchByID(item.ID)
+	if err != nil {
+		return err
+	}
+
+	if resource.Site != nil {
+		item.Site = resource.Site.Name
+	}
+
+	return nil
+}
@@ -2,17 +2,18 @@
 package advancedusersearches
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAdvancedUserSearch constructs an advanced user search object for create and update operations.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedUserSearch, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAdvancedUserSearch, error) {
 	resource := &jamfpro.ResourceAdvancedUserSearch{
 		Name: d.Get("name").(string),
 	}
@@ -49,7 +50,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedUserSearch, err
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Advanced User Search '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Advanced User Search XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Advanced User Search XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
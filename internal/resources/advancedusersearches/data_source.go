@@ -3,11 +3,11 @@ package advancedusersearches
 
 import (
 	"context"
-	"fmt"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,62 +17,63 @@ func DataSourceJamfProAdvancedUserSearches() *schema.Resource {
 		ReadContext: dataSourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API integration.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the advanced user search. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique name of the advanced mobile user search.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the advanced user search. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific API integration
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'display_name' attribute over the 'id'
-// attribute for fetching details. If neither 'display_name' nor 'id' is provided, it returns an error.
-// Once the details are fetched, they are set in the data source's state.
-//
-// Parameters:
-// - ctx: The context within which the function is called. It's used for timeouts and cancellation.
-// - d: The current state of the data source.
-// - meta: The meta object that can be used to retrieve the API client connection.
-//
-// Returns:
-// - diag.Diagnostics: Returns any diagnostics (errors or warnings) encountered during the function's execution.
+// dataSourceRead fetches the details of a specific advanced user search from Jamf Pro using either its unique name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*jamfpro.Client)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.client")
-	}
-
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
+	client := meta.(*jamfpro.Client)
 
-	var resource *jamfpro.ResourceAdvancedUserSearch
-
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = client.GetAdvancedUserSearchByID(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Advanced User Search",
+		"name",
+		client.GetAdvancedUserSearchByID,
+		client.GetAdvancedUserSearchByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
 
+// listNames returns the names of all advanced user searches in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetAdvancedUserSearches()
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Advanced User Search with ID '%s' after retries: %v", resourceID, err))
+		return nil, err
 	}
 
-	if resource != nil {
-		d.SetId(resourceID)
-		if err := d.Set("name", resource.Name); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Advanced User Search with ID '%s': %v", resourceID, err))...)
-		}
-	} else {
-		d.SetId("")
+	names := make([]string, 0, len(response.AdvancedUserSearch))
+	for _, item := range response.AdvancedUserSearch {
+		names = append(names, item.Name)
+	}
+
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the advanced user search returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceAdvancedUserSearch) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(strconv.Itoa(resource.ID))
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
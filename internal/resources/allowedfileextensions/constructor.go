@@ -2,16 +2,17 @@
 package allowedfileextensions
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAllowedFileExtension creates a new ResourceAllowedFileExtension instance from Terraform data and serializes it to XML.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAllowedFileExtension, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAllowedFileExtension, error) {
 	resource := &jamfpro.ResourceAllowedFileExtension{
 		Extension: d.Get("extension").(string),
 	}
@@ -21,7 +22,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAllowedFileExtension, e
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Allowed File Extension '%s' to XML: %v", resource.Extension, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Allowed File Extension XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Allowed File Extension XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
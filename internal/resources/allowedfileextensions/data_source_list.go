@@ -0,0 +1,62 @@
+// allowedfileextensions_data_source_list.go
+package allowedfileextensions
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the allowed file extensions list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "allowed file extension",
+}
+
+// DataSourceJamfProAllowedFileExtensionsList provides the allowed file extensions in Jamf Pro, optionally filtered
+// by name.
+func DataSourceJamfProAllowedFileExtensionsList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the allowed file extensions in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		nil,
+	)
+}
+
+// listItems returns all allowed file extensions in Jamf Pro as list items, named by their extension.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetAllowedFileExtensions()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.AllowedFileExtensions))
+	for _, item := range response.AllowedFileExtensions {
+		items = append(items, common.ListItem{
+			ID:   strconv.Itoa(item.ID),
+			Name: item.Extension,
+		})
+	}
+
+	return items, nil
+}
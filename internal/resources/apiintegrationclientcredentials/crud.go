@@ -0,0 +1,116 @@
+// apiintegrationclientcredentials_crud.go
+package apiintegrationclientcredentials
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for issuing the first client credentials of a Jamf Pro API Integration.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := rotate(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("api_integration_id").(string))
+
+	return append(diags, readNoCleanup(ctx, d, meta)...)
+}
+
+// read is responsible for checking that the API Integration of the client credentials still exists. The client
+// secret cannot be read back from Jamf Pro, so the value issued last is kept in state.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	resourceID := d.Id()
+	var diags diag.Diagnostics
+
+	var response *jamfpro.ResourceApiIntegration
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		response, apiErr = client.GetApiIntegrationByID(resourceID)
+		return common.RetryOnError(ctx, apiErr)
+	})
+
+	if err != nil {
+		return append(diags, common.HandleResourceNotFoundError(err, d, cleanup)...)
+	}
+
+	return append(diags, updateState(d, response)...)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for rotating the client secret when rotation_trigger or rotate_after change.
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChanges("rotation_trigger", "rotate_after") {
+		if err := rotate(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return append(diags, readNoCleanup(ctx, d, meta)...)
+}
+
+// delete is responsible for removing the client credentials from the Terraform state. Jamf Pro cannot revoke
+// client credentials without deleting the API Integration, so the last issued secret remains valid.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	logging.Warnf(ctx, logging.SubsystemCRUD, "Client credentials of Jamf Pro API Integration '%s' remain valid until rotated or the API Integration is deleted", d.Id())
+	d.SetId("")
+
+	return diags
+}
+
+// rotate issues new client credentials for the API Integration and stores them in the Terraform state.
+func rotate(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*jamfpro.Client)
+	apiIntegrationID := d.Get("api_integration_id").(string)
+
+	var credentials *jamfpro.ResourceClientCredentials
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var apiErr error
+		credentials, apiErr = client.RefreshClientCredentialsByApiRoleID(apiIntegrationID)
+		return common.RetryOnError(ctx, apiErr)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to issue client credentials for Jamf Pro API Integration (ID: %s) after retries: %v", apiIntegrationID, err)
+	}
+
+	logging.Infof(ctx, logging.SubsystemCRUD, "Issued new client credentials for Jamf Pro API Integration '%s'", apiIntegrationID)
+
+	credentialsData := map[string]interface{}{
+		"client_id":     credentials.ClientID,
+		"client_secret": credentials.ClientSecret,
+		"rotated_at":    time.Now().UTC().Format(time.RFC3339),
+	}
+
+	for key, val := range credentialsData {
+		if err := d.Set(key, val); err != nil {
+			return fmt.Errorf("failed to set '%s': %v", key, err)
+		}
+	}
+
+	return nil
+}
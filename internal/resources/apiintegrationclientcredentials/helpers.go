@@ -0,0 +1,24 @@
+// apiintegrationclientcredentials_helpers.go
+package apiintegrationclientcredentials
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customizeDiffRotation marks the client secret as changing when a rotation is planned, so that resources
+// consuming it see the new value as unknown instead of the secret about to be invalidated.
+func customizeDiffRotation(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.HasChanges("rotation_trigger", "rotate_after") {
+		return nil
+	}
+
+	for _, key := range []string{"client_secret", "rotated_at"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// apiintegrationclientcredentials_resource.go
+package apiintegrationclientcredentials
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProApiIntegrationClientCredentials defines the schema and CRUD operations for issuing client credentials
+// for a Jamf Pro API Integration in Terraform. Issuing credentials invalidates the previous client secret, so it only
+// happens on create and when rotation_trigger or rotate_after change.
+func ResourceJamfProApiIntegrationClientCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: customizeDiffRotation,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the API integration the client credentials belong to.",
+			},
+			"api_integration_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The unique identifier of the API integration to issue client credentials for.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which rotate the client secret when any of them change.",
+			},
+			"rotate_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "An RFC 3339 timestamp which rotates the client secret when it changes, for example the `rotation_rfc3339` of a `time_rotating` resource.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client ID of the API integration.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client secret issued for the API integration. Jamf Pro only returns it when it is issued.",
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The RFC 3339 timestamp at which the client secret was last issued.",
+			},
+		},
+	}
+}
@@ -0,0 +1,21 @@
+// apiintegrationclientcredentials_state.go
+package apiintegrationclientcredentials
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the API Integration the client credentials belong to.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceApiIntegration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := d.Set("client_id", resp.ClientID); err != nil {
+		diags = append(diags, diag.FromErr(fmt.Errorf("failed to set 'client_id': %v", err))...)
+	}
+
+	return diags
+}
@@ -2,16 +2,17 @@
 package apiintegrations
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProApiIntegration constructs a ResourceApiIntegration object from the provided schema data and serializes it to JSON.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceApiIntegration, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceApiIntegration, error) {
 	resource := &jamfpro.ResourceApiIntegration{
 		DisplayName:                d.Get("display_name").(string),
 		Enabled:                    d.Get("enabled").(bool),
@@ -36,7 +37,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceApiIntegration, error)
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Api Integration '%s' to JSON: %v", resource.DisplayName, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Api Integration JSON:\n%s\n", string(resourceJSON))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Api Integration JSON:\n%s", string(resourceJSON))
 
 	return resource, nil
 }
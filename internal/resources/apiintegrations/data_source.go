@@ -4,98 +4,116 @@ package apiintegrations
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
-
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // DataSourceJamfProApiIntegrations provides information about a specific API integration by its ID or Name.
+// It is read-only and never issues client credentials, use the jamfpro_api_integration_client_credentials resource for those.
 func DataSourceJamfProApiIntegrations() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API integration.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the API integration. Conflicts with `display_name`.",
+				ExactlyOneOf: []string{"id", "display_name"},
 			},
 			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique display name of the API integration. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "display_name"},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the API integration is enabled.",
+			},
+			"access_token_lifetime_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The access token lifetime in seconds for the API integration.",
+			},
+			"app_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The display name of the API integration.",
+				Description: "The app type of the API integration.",
 			},
 			"client_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "client id",
+				Description: "The client ID of the API integration.",
 			},
-			"client_secret": {
-				Type:        schema.TypeString,
+			"authorization_scopes": {
+				Type:        schema.TypeSet,
 				Computed:    true,
-				Description: "client secret",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The list of authorization roles scoped to the API integration.",
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific API integration
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'display_name' attribute over the 'id'
-// attribute for fetching details. If neither 'display_name' nor 'id' is provided, it returns an error.
-// Once the details are fetched, they are set in the data source's state.
-//
-// Parameters:
-// - ctx: The context within which the function is called. It's used for timeouts and cancellation.
-// - d: The current state of the data source.
-// - meta: The meta object that can be used to retrieve the API client connection.
-//
-// Returns:
-// - diag.Diagnostics: Returns any diagnostics (errors or warnings) encountered during the function's execution.
+// dataSourceRead fetches the details of a specific API integration from Jamf Pro using either its unique display name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
 
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
-
-	var resource *jamfpro.ResourceApiIntegration
-
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = client.GetApiIntegrationByID(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"API Integration",
+		"display_name",
+		client.GetApiIntegrationByID,
+		client.GetApiIntegrationByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
 
+// listNames returns the display names of all API integrations in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetApiIntegrations("")
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro API Integration with ID '%s' after retries: %v", resourceID, err))
+		return nil, err
 	}
 
-	if resource == nil {
-		d.SetId("")
-		return append(diags, diag.FromErr(fmt.Errorf("recieved empty resource"))...)
+	names := make([]string, 0, len(response.Results))
+	for _, item := range response.Results {
+		names = append(names, item.DisplayName)
 	}
 
-	d.SetId(resourceID)
+	return names, nil
+}
 
-	resp, err := client.RefreshClientCredentialsByApiRoleID(resourceID)
-	if err != nil {
-		return append(diags, diag.FromErr(err)...)
-	}
+// dataSourceUpdateState updates the Terraform state with the API integration returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceApiIntegration) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	if err = d.Set("display_name", resource.DisplayName); err != nil {
-		diags = append(diags, diag.FromErr(err)...)
-	}
+	d.SetId(strconv.Itoa(resource.ID))
 
-	if err = d.Set("client_id", resource.ClientID); err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+	apiIntegrationData := map[string]interface{}{
+		"display_name":                  resource.DisplayName,
+		"enabled":                       resource.Enabled,
+		"access_token_lifetime_seconds": resource.AccessTokenLifetimeSeconds,
+		"app_type":                      resource.AppType,
+		"client_id":                     resource.ClientID,
+		"authorization_scopes":          resource.AuthorizationScopes,
 	}
 
-	if err = d.Set("client_secret", resp.ClientSecret); err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+	for key, val := range apiIntegrationData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(fmt.Errorf("failed to set '%s': %v", key, err))...)
+		}
 	}
 
 	return diags
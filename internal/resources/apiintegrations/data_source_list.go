@@ -0,0 +1,78 @@
+// apiintegrations_data_source_list.go
+package apiintegrations
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the API integrations list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "API integration",
+	Attributes: map[string]*schema.Schema{
+		"client_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The client ID of the API integration.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the API integration is enabled.",
+		},
+	},
+}
+
+// DataSourceJamfProApiIntegrationsList provides the API integrations in Jamf Pro, optionally filtered by display name.
+// Client secrets are never returned.
+func DataSourceJamfProApiIntegrationsList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the API integrations in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		nil,
+	)
+}
+
+// listItems returns all API integrations in Jamf Pro as list items.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetApiIntegrations("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.Results))
+	for _, item := range response.Results {
+		items = append(items, common.ListItem{
+			ID:   strconv.Itoa(item.ID),
+			Name: item.DisplayName,
+			Attributes: map[string]interface{}{
+				"client_id": item.ClientID,
+				"enabled":   item.Enabled,
+			},
+		})
+	}
+
+	return items, nil
+}
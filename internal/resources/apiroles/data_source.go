@@ -3,12 +3,10 @@ package apiroles
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
-
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,48 +16,63 @@ func DataSourceJamfProAPIRoles() *schema.Resource {
 		ReadContext: dataSourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API role.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the API role. Conflicts with `display_name`.",
+				ExactlyOneOf: []string{"id", "display_name"},
 			},
 			"display_name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique name of the Jamf Pro API role.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique display name of the API role. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "display_name"},
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific API role from Jamf Pro using either its unique Name or its Id.
-// The function prioritizes the 'name' attribute over the 'id' attribute for fetching details. If neither 'name' nor 'id' is provided,
-// it returns an error. Once the details are fetched, they are set in the data source's state.
+// dataSourceRead fetches the details of a specific API role from Jamf Pro using either its unique display name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
 
-	var resource *jamfpro.ResourceAPIRole
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = client.GetJamfApiRoleByID(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"API Role",
+		"display_name",
+		client.GetJamfApiRoleByID,
+		client.GetJamfApiRoleByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
 
+// listNames returns the display names of all API roles in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetJamfAPIRoles("")
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro API Role with ID '%s' after retries: %v", resourceID, err))
+		return nil, err
+	}
+
+	names := make([]string, 0, len(response.Results))
+	for _, item := range response.Results {
+		names = append(names, item.DisplayName)
 	}
 
-	if resource != nil {
-		d.SetId(resourceID)
-		if err := d.Set("display_name", resource.DisplayName); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'display_name' for Jamf Pro API Role with ID '%s': %v", resourceID, err))...)
-		}
-	} else {
-		d.SetId("")
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the API role returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceAPIRole) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(resource.ID)
+	if err := d.Set("display_name", resource.DisplayName); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
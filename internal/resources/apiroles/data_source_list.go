@@ -0,0 +1,60 @@
+// apiroles_data_source_list.go
+package apiroles
+
+import (
+	"context"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the API roles list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "API role",
+}
+
+// DataSourceJamfProAPIRolesList provides the API roles in Jamf Pro, optionally filtered by name.
+func DataSourceJamfProAPIRolesList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the API roles in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		nil,
+	)
+}
+
+// listItems returns all API roles in Jamf Pro as list items.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetJamfAPIRoles("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.Results))
+	for _, item := range response.Results {
+		items = append(items, common.ListItem{
+			ID:   item.ID,
+			Name: item.DisplayName,
+		})
+	}
+
+	return items, nil
+}
@@ -2,16 +2,17 @@
 package buildings
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProBuilding constructs a Building object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceBuilding, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceBuilding, error) {
 	resource := &jamfpro.ResourceBuilding{
 		Name:           d.Get("name").(string),
 		StreetAddress1: d.Get("street_address1").(string),
@@ -28,7 +29,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceBuilding, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Building '%s' to JSON: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Building JSON:\n%s\n", string(resourceJSON))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Building JSON:\n%s", string(resourceJSON))
 
 	return resource, nil
 }
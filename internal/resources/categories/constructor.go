@@ -2,16 +2,17 @@
 package categories
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProCategory constructs a Jamf Pro Category struct from Terraform resource data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceCategory, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceCategory, error) {
 	resource := &jamfpro.ResourceCategory{
 		Name:     d.Get("name").(string),
 		Priority: d.Get("priority").(int),
@@ -22,7 +23,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceCategory, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Category '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Category XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Category XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
@@ -3,9 +3,9 @@ package categories
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,38 +16,63 @@ func DataSourceJamfProCategories() *schema.Resource {
 		ReadContext: dataSourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the Category.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the category. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique name of the jamf pro Category.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the category. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific category from Jamf Pro using its unique ID.
+// dataSourceRead fetches the details of a specific category from Jamf Pro using either its unique name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
 
-	Category, err := client.GetCategoryByID(resourceID)
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Category",
+		"name",
+		client.GetCategoryByID,
+		client.GetCategoryByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
+
+// listNames returns the names of all categories in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetCategories("")
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Category with ID '%s': %v", resourceID, err))
+		return nil, err
 	}
 
-	if Category != nil {
-		d.SetId(resourceID)
-		if err := d.Set("name", Category.Name); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Category with ID '%s': %v", resourceID, err))...)
-		}
+	names := make([]string, 0, len(response.Results))
+	for _, item := range response.Results {
+		names = append(names, item.Name)
+	}
+
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the category returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceCategory) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	} else {
-		d.SetId("")
+	d.SetId(resource.Id)
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
@@ -3,11 +3,12 @@ package datavalidators
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"howett.net/plist"
 )
 
@@ -20,7 +21,7 @@ func GetPayloadScope(plistData map[string]interface{}) (string, error) {
 }
 
 // CheckPlistIndentationAndWhiteSpace checks the plist XML for proper indentation and whitespace.
-func CheckPlistIndentationAndWhiteSpace(plistStr string) error {
+func CheckPlistIndentationAndWhiteSpace(ctx context.Context, plistStr string) error {
 	// Decode the plist XML
 	var decoded interface{}
 
@@ -44,13 +45,13 @@ func CheckPlistIndentationAndWhiteSpace(plistStr string) error {
 	formattedLines := strings.Split(normalizedFormatted, "\n")
 
 	if len(origLines) != len(formattedLines) {
-		log.Printf("[DEBUG] Line count mismatch: original has %d lines, formatted has %d lines", len(origLines), len(formattedLines))
+		logging.Debugf(ctx, logging.SubsystemPlist, "Line count mismatch: original has %d lines, formatted has %d lines", len(origLines), len(formattedLines))
 		return fmt.Errorf("plist line count mismatch: source has %d lines, formatted has %d lines, check for trailing lines and whitespace", len(origLines), len(formattedLines))
 	}
 
 	for i := range origLines {
 		if origLines[i] != formattedLines[i] {
-			log.Printf("[DEBUG] Difference at line %d:\nOriginal: %s\nFormatted: %s\n", i+1, origLines[i], formattedLines[i])
+			logging.Debugf(ctx, logging.SubsystemPlist, "Difference at line %d:\nOriginal: %s\nFormatted: %s", i+1, origLines[i], formattedLines[i])
 			return fmt.Errorf("plist is not properly indented at line %d", i+1)
 		}
 	}
@@ -4,12 +4,13 @@
 package plist
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"html"
-	"log"
 	"strconv"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"howett.net/plist"
@@ -29,7 +30,7 @@ func ConvertHCLToPlist(d *schema.ResourceData) (string, error) {
 	}
 	unescapedPrettyPlistXML := html.UnescapeString(string(prettyPlistXML))
 
-	log.Printf("[DEBUG] Constructed Plist XML from HCL serialization:\n%s\n", unescapedPrettyPlistXML)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Constructed Plist XML from HCL serialization:\n%s", unescapedPrettyPlistXML)
 
 	return string(plistData), nil
 }
@@ -136,7 +137,7 @@ func ConvertPlistToHCL(plistXML string) ([]interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal profile to JSON: %w", err)
 	}
-	log.Printf("[DEBUG] Unmarshaled profile: %s", string(profileData))
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Unmarshaled profile: %s", string(profileData))
 
 	if profile.PayloadContent != nil {
 		for i, content := range profile.PayloadContent {
@@ -144,10 +145,10 @@ func ConvertPlistToHCL(plistXML string) ([]interface{}, error) {
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal content to JSON: %w", err)
 			}
-			log.Printf("[DEBUG] PayloadContent %d: %s", i, string(contentData))
+			logging.Debugf(context.Background(), logging.SubsystemPlist, "PayloadContent %d: %s", i, string(contentData))
 		}
 	} else {
-		log.Printf("[DEBUG] PayloadContent is nil")
+		logging.Debugf(context.Background(), logging.SubsystemPlist, "PayloadContent is nil")
 	}
 
 	payloadsList, err := mapProfileToSchema(profile)
@@ -161,7 +162,7 @@ func ConvertPlistToHCL(plistXML string) ([]interface{}, error) {
 		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	log.Printf("[DEBUG] Constructed TF state structure from plist:\n%s\n", string(jsonData))
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Constructed TF state structure from plist:\n%s", string(jsonData))
 
 	return payloadsList, nil
 }
@@ -195,10 +196,10 @@ func mapProfileToSchema(profile *ConfigurationProfile) ([]interface{}, error) {
 			"payload_scope":        content.PayloadScope,
 		}
 
-		log.Printf("[DEBUG] ConfigurationItems being passed: %v", content.ConfigurationItems)
+		logging.Debugf(context.Background(), logging.SubsystemPlist, "ConfigurationItems being passed: %v", content.ConfigurationItems)
 		settingsList := []interface{}{}
 		extractNestedConfigurationSettings(content.ConfigurationItems, &settingsList)
-		log.Printf("[DEBUG] Final settingsList: %v", settingsList)
+		logging.Debugf(context.Background(), logging.SubsystemPlist, "Final settingsList: %v", settingsList)
 
 		payloadContent["setting"] = settingsList
 		payloadContentList = append(payloadContentList, payloadContent)
@@ -211,9 +212,9 @@ func mapProfileToSchema(profile *ConfigurationProfile) ([]interface{}, error) {
 
 // extractNestedConfigurationSettings recursively extracts key-value pairs from nested dictionaries and appends them to settingsList
 func extractNestedConfigurationSettings(items map[string]interface{}, settingsList *[]interface{}) {
-	log.Printf("[DEBUG] Raw data being processed: %v", items)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Raw data being processed: %v", items)
 	for key, value := range items {
-		log.Printf("[DEBUG] Processing configuration item key: %s, value: %v", key, value)
+		logging.Debugf(context.Background(), logging.SubsystemPlist, "Processing configuration item key: %s, value: %v", key, value)
 		settingMap := map[string]interface{}{
 			"key": key,
 		}
@@ -245,7 +246,7 @@ func extractNestedConfigurationSettings(items map[string]interface{}, settingsLi
 			settingMap["value"] = v
 		}
 
-		log.Printf("[DEBUG] Adding settingMap: %v", settingMap)
+		logging.Debugf(context.Background(), logging.SubsystemPlist, "Adding settingMap: %v", settingMap)
 		*settingsList = append(*settingsList, settingMap)
 	}
 }
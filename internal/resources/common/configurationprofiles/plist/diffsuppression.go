@@ -3,34 +3,35 @@
 package plist
 
 import (
-	"log"
+	"context"
 	"strconv"
 	"strings"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"howett.net/plist"
 )
 
 // ProcessConfigurationProfileForDiffSuppression processes the plist data, removes specified fields, and returns the cleaned plist XML as a string.
 func ProcessConfigurationProfileForDiffSuppression(plistData string, fieldsToRemove []string) (string, error) {
-	log.Println("Starting ProcessConfigurationProfile")
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Starting ProcessConfigurationProfile")
 
 	// Decode and clean the plist data
 	plistBytes := []byte(plistData)
 	cleanedData, err := decodeAndCleanPlist(plistBytes, fieldsToRemove)
 	if err != nil {
-		log.Printf("Error decoding and cleaning plist data: %v\n", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error decoding and cleaning plist data: %v", err)
 		return "", err
 	}
 
 	// Sort keys for consistent order
 	sortedData := SortPlistKeys(cleanedData)
 
-	log.Printf("Sorted plist data: %v\n", sortedData)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Sorted plist data: %v", sortedData)
 
 	// Encode the cleaned and sorted data back to plist XML format
 	encodedPlist, err := EncodePlist(sortedData)
 	if err != nil {
-		log.Printf("Error encoding cleaned data to plist: %v\n", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error encoding cleaned data to plist: %v", err)
 		return "", err
 	}
 
@@ -42,13 +43,13 @@ func decodeAndCleanPlist(plistData []byte, fieldsToRemove []string) (map[string]
 	var rawData map[string]interface{}
 	_, err := plist.Unmarshal(plistData, &rawData)
 	if err != nil {
-		log.Printf("Error unmarshalling plist data: %v\n", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error unmarshalling plist data: %v", err)
 		return nil, err
 	}
 
-	log.Printf("Raw plist data: %v\n", rawData)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Raw plist data: %v", rawData)
 	RemoveFields(rawData, fieldsToRemove, "")
-	log.Printf("Cleaned plist data: %v\n", rawData)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Cleaned plist data: %v", rawData)
 
 	return rawData, nil
 }
@@ -70,7 +71,7 @@ func recursivelyRemoveFields(data map[string]interface{}, fieldsToRemoveSet map[
 	// Iterate over the map and remove fields if they exist
 	for field := range fieldsToRemoveSet {
 		if _, exists := data[field]; exists {
-			log.Printf("Removing field: %s from path: %s\n", field, path)
+			logging.Debugf(context.Background(), logging.SubsystemPlist, "Removing field: %s from path: %s", field, path)
 			delete(data, field)
 		}
 	}
@@ -80,12 +81,12 @@ func recursivelyRemoveFields(data map[string]interface{}, fieldsToRemoveSet map[
 		newPath := path + "/" + key
 		switch v := value.(type) {
 		case map[string]interface{}:
-			log.Printf("Recursively removing fields in nested map at path: %s\n", newPath)
+			logging.Debugf(context.Background(), logging.SubsystemPlist, "Recursively removing fields in nested map at path: %s", newPath)
 			recursivelyRemoveFields(v, fieldsToRemoveSet, newPath)
 		case []interface{}:
 			for i, item := range v {
 				if nestedMap, ok := item.(map[string]interface{}); ok {
-					log.Printf("Recursively removing fields in array at path: %s[%d]\n", newPath, i)
+					logging.Debugf(context.Background(), logging.SubsystemPlist, "Recursively removing fields in array at path: %s[%d]", newPath, i)
 					recursivelyRemoveFields(nestedMap, fieldsToRemoveSet, newPath+strings.ReplaceAll(key, "/", "_")+strconv.Itoa(i))
 				}
 			}
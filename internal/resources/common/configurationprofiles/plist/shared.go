@@ -3,9 +3,10 @@ package plist
 
 import (
 	"bytes"
-	"log"
+	"context"
 	"sort"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"howett.net/plist"
 )
 
@@ -14,7 +15,7 @@ func DecodePlist(plistData []byte) (map[string]interface{}, error) {
 	var rawData map[string]interface{}
 	_, err := plist.Unmarshal(plistData, &rawData)
 	if err != nil {
-		log.Printf("Error unmarshalling plist data: %v\n", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error unmarshalling plist data: %v", err)
 		return nil, err
 	}
 	return rawData, nil
@@ -22,13 +23,13 @@ func DecodePlist(plistData []byte) (map[string]interface{}, error) {
 
 // EncodePlist encodes a cleaned map back to plist XML format
 func EncodePlist(cleanedData map[string]interface{}) (string, error) {
-	log.Printf("Encoding plist data: %v\n", cleanedData)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Encoding plist data: %v", cleanedData)
 	var buffer bytes.Buffer
 	encoder := plist.NewEncoder(&buffer)
 	encoder.Indent("\t") // Optional: for pretty-printing the XML
 
 	if err := encoder.Encode(cleanedData); err != nil {
-		log.Printf("Error encoding plist data: %v\n", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error encoding plist data: %v", err)
 		return "", err
 	}
 
@@ -45,20 +46,20 @@ func SortPlistKeys(data map[string]interface{}) map[string]interface{} {
 	for k := range data {
 		keys = append(keys, k)
 	}
-	log.Printf("Unsorted keys: %v\n", keys)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Unsorted keys: %v", keys)
 	sort.Strings(keys)
-	log.Printf("Sorted keys: %v\n", keys)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Sorted keys: %v", keys)
 	for _, k := range keys {
-		log.Printf("Processing key: %s\n", k)
+		logging.Debugf(context.Background(), logging.SubsystemPlist, "Processing key: %s", k)
 		switch v := data[k].(type) {
 		case map[string]interface{}:
-			log.Printf("Key %s is a nested map, sorting nested keys...\n", k)
+			logging.Debugf(context.Background(), logging.SubsystemPlist, "Key %s is a nested map, sorting nested keys...", k)
 			sortedData[k] = SortPlistKeys(v)
 		case []interface{}:
-			log.Printf("Key %s is an array, processing items...\n", k)
+			logging.Debugf(context.Background(), logging.SubsystemPlist, "Key %s is an array, processing items...", k)
 			sortedArray := make([]interface{}, len(v))
 			for i, item := range v {
-				log.Printf("Processing item %d of array %s\n", i, k)
+				logging.Debugf(context.Background(), logging.SubsystemPlist, "Processing item %d of array %s", i, k)
 				if nestedMap, ok := item.(map[string]interface{}); ok {
 					sortedArray[i] = SortPlistKeys(nestedMap)
 				} else {
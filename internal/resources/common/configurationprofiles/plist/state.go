@@ -3,35 +3,37 @@
 package plist
 
 import (
-	"log"
+	"context"
 	"sort"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 )
 
 // ProcessConfigurationProfileForState processes the plist data, removes specified fields, and returns the cleaned plist XML as a string.
 func ProcessConfigurationProfileForState(plistData string) (string, error) {
-	log.Println("Starting ProcessConfigurationProfile")
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Starting ProcessConfigurationProfile")
 
 	// Decode and clean the plist data
 	plistBytes := []byte(plistData)
-	log.Printf("Decoding plist data: %s\n", plistData)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Decoding plist data: %s", plistData)
 	decodedPlist, err := DecodePlist(plistBytes)
 	if err != nil {
-		log.Printf("Error decoding plist data: %v\n", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error decoding plist data: %v", err)
 		return "", err
 	}
 
 	// Sort keys for consistent order
-	log.Println("Sorting keys for consistent order...")
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Sorting keys for consistent order...")
 	sortedData := SortPlistKeys(decodedPlist)
 
 	// Encode the cleaned and sorted data back to plist XML format
 	encodedPlist, err := EncodePlist(sortedData)
 	if err != nil {
-		log.Printf("Error encoding cleaned data to plist: %v\n", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error encoding cleaned data to plist: %v", err)
 		return "", err
 	}
 
-	log.Printf("Successfully processed configuration profile\n")
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Successfully processed configuration profile")
 	return encodedPlist, nil
 }
 
@@ -0,0 +1,39 @@
+// common/criteria/priority.go
+// This package holds the criteria validation shared by the smart computer and mobile device group resources
+package criteria
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ValidatePriority ensures the first criterion of a resource of resourceType, e.g. "jamfpro_smart_computer_group",
+// has a priority of 0 and each subsequent criterion has a priority incremented by 1.
+func ValidatePriority(diff *schema.ResourceDiff, resourceType string) error {
+	criteria, ok := diff.Get("criteria").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	resourceName, ok := diff.Get("name").(string)
+	if !ok {
+		return fmt.Errorf("unable to retrieve resource name for validation")
+	}
+
+	return validatePriorities(criteria, resourceType+"."+resourceName)
+}
+
+// validatePriorities checks the priorities of the criteria of the resource at address.
+func validatePriorities(criteria []interface{}, address string) error {
+	for index, criterion := range criteria {
+		priority := criterion.(map[string]interface{})["priority"].(int)
+		if index == 0 && priority != 0 {
+			return fmt.Errorf("in '%s': the first criterion must have a priority of 0, got %d", address, priority)
+		} else if index > 0 && priority != index {
+			return fmt.Errorf("in '%s': criterion %d has an invalid priority %d, expected %d", address, index, priority, index)
+		}
+	}
+
+	return nil
+}
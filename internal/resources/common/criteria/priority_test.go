@@ -0,0 +1,22 @@
+package criteria
+
+import "testing"
+
+func TestValidatePriorities(t *testing.T) {
+	criterion := func(priority int) interface{} { return map[string]interface{}{"priority": priority} }
+
+	for name, tc := range map[string]struct {
+		criteria []interface{}
+		valid    bool
+	}{
+		"none":           {nil, true},
+		"sequential":     {[]interface{}{criterion(0), criterion(1), criterion(2)}, true},
+		"first not zero": {[]interface{}{criterion(1), criterion(2)}, false},
+		"gap":            {[]interface{}{criterion(0), criterion(2)}, false},
+		"repeated":       {[]interface{}{criterion(0), criterion(0)}, false},
+	} {
+		if err := validatePriorities(tc.criteria, "jamfpro_smart_computer_group.test"); (err == nil) != tc.valid {
+			t.Errorf("%s: expected valid to be %v, got error %v", name, tc.valid, err)
+		}
+	}
+}
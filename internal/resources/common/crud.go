@@ -11,7 +11,7 @@ import (
 )
 
 // Func Definitions
-type payoadConstructorFunc[T any] func(*schema.ResourceData) (*T, error)
+type payoadConstructorFunc[T any] func(context.Context, *schema.ResourceData) (*T, error)
 
 type sdkCreateUpdateFunc[PayloadType any, ResponseType any] func(Payload *PayloadType) (*ResponseType, error)
 
@@ -37,7 +37,7 @@ func Create[sdkPayloadType any, sdkResponseType any](
 
 	var diags diag.Diagnostics
 
-	payload, err := construct(d)
+	payload, err := construct(ctx, d)
 	payloadtypeName := reflect.TypeOf(payload).Name()
 
 	if err != nil {
@@ -48,10 +48,7 @@ func Create[sdkPayloadType any, sdkResponseType any](
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var apiErr error
 		outcomeResponse, apiErr = serverOutcomeFunc(payload)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
+		return RetryOnError(ctx, apiErr)
 	})
 
 	if err != nil {
@@ -81,7 +78,7 @@ func Update[sdkPayloadType any, sdkResponseType any](
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	payload, err := constructor(d)
+	payload, err := constructor(ctx, d)
 	payloadtypeName := reflect.TypeOf(payload).Name()
 
 	if err != nil {
@@ -90,10 +87,7 @@ func Update[sdkPayloadType any, sdkResponseType any](
 
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := outcomeFunc(resourceID, payload)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
+		return RetryOnError(ctx, apiErr)
 	})
 
 	if err != nil {
@@ -120,10 +114,7 @@ func Read[sdkResponseType any](
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
 		response, apiErr = serverOutcomeFunc(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
+		return RetryOnError(ctx, apiErr)
 	})
 
 	if err != nil {
@@ -140,13 +131,11 @@ func Delete(ctx context.Context, d *schema.ResourceData, meta interface{}, serve
 
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := serverOutcomeFunc(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
+		return RetryOnError(ctx, apiErr)
 	})
 
-	if err != nil {
+	// A resource which no longer exists has already reached the desired state.
+	if err != nil && !IsNotFoundError(err) {
 		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro resource '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
 	}
 
@@ -0,0 +1,119 @@
+// common/datasource.go
+// This package contains shared / common functions for data source lookups
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sdkListNamesFunc returns the names of every object of a type, used to detect ambiguous lookups by name.
+// It may be nil where the type cannot be listed reliably, in which case the ambiguity check is skipped.
+type sdkListNamesFunc func() ([]string, error)
+
+// DataSourceRead fetches a single object for a data source using whichever of "id" or nameKey is configured,
+// and states it. A lookup by name fails with a clear error when the name matches no object or more than one.
+func DataSourceRead[sdkResponseType any](
+	ctx context.Context,
+	d *schema.ResourceData,
+	resourceTypeName string,
+	nameKey string,
+	getByID sdkGetFunc[sdkResponseType],
+	getByName sdkGetFunc[sdkResponseType],
+	listNames sdkListNamesFunc,
+	providerStateFunc providerStateFunc[sdkResponseType],
+) diag.Diagnostics {
+	resourceID := d.Get("id").(string)
+	resourceName := d.Get(nameKey).(string)
+
+	var response *sdkResponseType
+	var err error
+
+	switch {
+	case resourceID != "":
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+			var apiErr error
+			response, apiErr = getByID(resourceID)
+			return RetryOnError(ctx, apiErr)
+		})
+
+		if err != nil {
+			if IsNotFoundError(err) {
+				return diag.Errorf("no Jamf Pro %s found with ID '%s'", resourceTypeName, resourceID)
+			}
+			return diag.FromErr(fmt.Errorf("failed to read Jamf Pro %s with ID '%s' after retries: %v", resourceTypeName, resourceID, err))
+		}
+
+	case resourceName != "":
+		if listNames != nil {
+			if diags := ensureUniqueName(ctx, d, resourceTypeName, nameKey, resourceName, listNames); diags.HasError() {
+				return diags
+			}
+		}
+
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+			var apiErr error
+			response, apiErr = getByName(resourceName)
+			return RetryOnError(ctx, apiErr)
+		})
+
+		if err != nil {
+			if IsNotFoundError(err) {
+				return diag.Errorf("no Jamf Pro %s found with %s '%s'", resourceTypeName, nameKey, resourceName)
+			}
+			return diag.FromErr(fmt.Errorf("failed to read Jamf Pro %s with %s '%s' after retries: %v", resourceTypeName, nameKey, resourceName, err))
+		}
+
+	default:
+		return diag.Errorf("one of 'id' or '%s' must be provided to look up a Jamf Pro %s", nameKey, resourceTypeName)
+	}
+
+	if response == nil {
+		d.SetId("")
+		return diag.Errorf("received an empty response for Jamf Pro %s", resourceTypeName)
+	}
+
+	return providerStateFunc(d, response)
+}
+
+// ensureUniqueName checks that exactly one object of a type carries the given name.
+func ensureUniqueName(
+	ctx context.Context,
+	d *schema.ResourceData,
+	resourceTypeName string,
+	nameKey string,
+	resourceName string,
+	listNames sdkListNamesFunc,
+) diag.Diagnostics {
+	var names []string
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		names, apiErr = listNames()
+		return RetryOnError(ctx, apiErr)
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list Jamf Pro %s objects after retries: %v", resourceTypeName, err))
+	}
+
+	matches := 0
+	for _, name := range names {
+		if name == resourceName {
+			matches++
+		}
+	}
+
+	switch matches {
+	case 0:
+		return diag.Errorf("no Jamf Pro %s found with %s '%s'", resourceTypeName, nameKey, resourceName)
+	case 1:
+		return nil
+	default:
+		return diag.Errorf("%d Jamf Pro %s objects found with %s '%s', use 'id' to select one", matches, resourceTypeName, nameKey, resourceName)
+	}
+}
@@ -0,0 +1,246 @@
+// common/datasource_list.go
+// This package contains shared / common functions for plural data sources listing every object of a type
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ListItem is a single object returned by a list data source.
+type ListItem struct {
+	ID       string
+	Name     string
+	Category string
+	Site     string
+	// Attributes holds the values of any type specific attributes declared in ListDataSourceConfig.Attributes.
+	Attributes map[string]interface{}
+}
+
+// sdkListFunc returns every object of a type as list items.
+type sdkListFunc func() ([]ListItem, error)
+
+// sdkDescribeFunc completes a list item with the category and site omitted by the list endpoint of its type.
+// It is set for the types declared FilterOnly, nil for the others, and only called when filtering on them.
+type sdkDescribeFunc func(item *ListItem) error
+
+// ListDataSourceConfig describes a plural data source listing every object of a type.
+type ListDataSourceConfig struct {
+	// ResourceTypeName is the singular, lower case name of the type used in descriptions and errors.
+	ResourceTypeName string
+	// Category defines whether objects of the type belong to a category which can be filtered on.
+	Category bool
+	// Site defines whether objects of the type belong to a site which can be filtered on.
+	Site bool
+	// FilterOnly defines whether the list endpoint of the type omits the category and site of each object, so
+	// that they are only read, one object at a time, when the category or site filter is set.
+	FilterOnly bool
+	// Attributes declares any further computed attributes of each item.
+	Attributes map[string]*schema.Schema
+}
+
+// Schema returns the schema of the list data source. The category and site filters and item attributes
+// are only added for types which carry them.
+func (c ListDataSourceConfig) Schema() map[string]*schema.Schema {
+	resourceTypeName := c.ResourceTypeName
+
+	var filterOnly string
+	if c.FilterOnly {
+		filterOnly = fmt.Sprintf(" Empty unless the `category` or `site` filter is set, as listing %s objects does not return it.", resourceTypeName)
+	}
+
+	itemSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The unique identifier of the %s.", resourceTypeName),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s.", resourceTypeName),
+		},
+	}
+
+	out := map[string]*schema.Schema{
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  fmt.Sprintf("A regular expression which the name of each %s must match to be returned.", resourceTypeName),
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("The identifiers of the matching %s objects, ordered by name.", resourceTypeName),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("The names of the matching %s objects, ordered by name.", resourceTypeName),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	if c.Category {
+		out["category"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Only return %s objects in the category with this name.", resourceTypeName),
+		}
+		itemSchema["category"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the category of the %s.", resourceTypeName) + filterOnly,
+		}
+	}
+
+	if c.Site {
+		out["site"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Only return %s objects in the site with this name.", resourceTypeName),
+		}
+		itemSchema["site"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the site of the %s.", resourceTypeName) + filterOnly,
+		}
+	}
+
+	for key, attribute := range c.Attributes {
+		itemSchema[key] = attribute
+	}
+
+	out["items"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: fmt.Sprintf("The matching %s objects, ordered by name.", resourceTypeName),
+		Elem:        &schema.Resource{Schema: itemSchema},
+	}
+
+	return out
+}
+
+// Read lists every object of the type, filters them by the configured name_regex, category and site,
+// and states the result. Describing an item costs an API call, so items are only described when a category or
+// site filter is set and once they have passed the name filter.
+func (c ListDataSourceConfig) Read(ctx context.Context, d *schema.ResourceData, list sdkListFunc, describe sdkDescribeFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+	resourceTypeName := c.ResourceTypeName
+
+	nameRegex := d.Get("name_regex").(string)
+
+	var categoryFilter, siteFilter string
+	if c.Category {
+		categoryFilter = d.Get("category").(string)
+	}
+	if c.Site {
+		siteFilter = d.Get("site").(string)
+	}
+
+	var pattern *regexp.Regexp
+	if nameRegex != "" {
+		var err error
+		if pattern, err = regexp.Compile(nameRegex); err != nil {
+			return diag.FromErr(fmt.Errorf("invalid name_regex '%s': %v", nameRegex, err))
+		}
+	}
+
+	var items []ListItem
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		items, apiErr = list()
+		return RetryOnError(ctx, apiErr)
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list Jamf Pro %s objects after retries: %v", resourceTypeName, err))
+	}
+
+	describeItems := describe != nil && (categoryFilter != "" || siteFilter != "")
+
+	matched := make([]ListItem, 0, len(items))
+	for _, item := range items {
+		if pattern != nil && !pattern.MatchString(item.Name) {
+			continue
+		}
+
+		if describeItems {
+			err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+				return RetryOnError(ctx, describe(&item))
+			})
+
+			// An object deleted between listing and describing it no longer exists to be returned.
+			if IsNotFoundError(err) {
+				continue
+			}
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to read Jamf Pro %s '%s' (ID: %s) after retries: %v", resourceTypeName, item.Name, item.ID, err))
+			}
+		}
+
+		if categoryFilter != "" && !strings.EqualFold(item.Category, categoryFilter) {
+			continue
+		}
+		if siteFilter != "" && !strings.EqualFold(item.Site, siteFilter) {
+			continue
+		}
+
+		matched = append(matched, item)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].Name != matched[j].Name {
+			return matched[i].Name < matched[j].Name
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	ids := make([]string, 0, len(matched))
+	names := make([]string, 0, len(matched))
+	states := make([]interface{}, 0, len(matched))
+	for _, item := range matched {
+		ids = append(ids, item.ID)
+		names = append(names, item.Name)
+
+		state := map[string]interface{}{
+			"id":   item.ID,
+			"name": item.Name,
+		}
+		if c.Category {
+			state["category"] = item.Category
+		}
+		if c.Site {
+			state["site"] = item.Site
+		}
+		for key, value := range item.Attributes {
+			state[key] = value
+		}
+		states = append(states, state)
+	}
+
+	d.SetId(HashString(strings.Join([]string{resourceTypeName, nameRegex, categoryFilter, siteFilter}, "|")))
+
+	if err := d.Set("ids", ids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("names", names); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("items", states); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,308 @@
+// common/errors.go
+// This package contains shared / common functions for classifying errors returned by the Jamf Pro SDK
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// apiErrorMarker is the start of the JSON document the http client embeds in error messages.
+// The SDK wraps http client errors with %v, so the typed error is lost and only its JSON form survives.
+const apiErrorMarker = `{"status_code":`
+
+// classicAPINotFoundMessage is the body returned by the Classic API when an object does not exist.
+const classicAPINotFoundMessage = "The server has not found anything matching the request URI"
+
+// transientNetworkErrors are fragments of network level error messages which are worth retrying.
+var transientNetworkErrors = []string{
+	"connection reset by peer",
+	"connection refused",
+	"broken pipe",
+	"i/o timeout",
+	"TLS handshake timeout",
+	"Client.Timeout exceeded",
+	"server closed idle connection",
+	"unexpected EOF",
+	": EOF",
+}
+
+// APIError is a classified error returned from a call to the Jamf Pro API.
+type APIError struct {
+	// StatusCode is the HTTP status code of the failed request, 0 if the request never received a response.
+	StatusCode int
+	// Method is the HTTP method of the failed request, if known.
+	Method string
+	// URL is the URL of the failed request, if known.
+	URL string
+	// Retryable defines whether repeating the request could succeed.
+	Retryable bool
+	// RetryAfter is the delay requested by the server through the Retry-After header, if any.
+	RetryAfter time.Duration
+	// Err is the original error.
+	Err error
+}
+
+// Error returns the message of the original error.
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// ClassifyError inspects an error returned by the SDK and returns a typed APIError.
+// A response is retryable when IsRetryableStatus allows repeating it and the HTTP layer has not already
+// exhausted its retries for the request, so a resource does not repeat what the HTTP layer gave up on.
+// 409 responses are retryable as well. Resolving a conflict usually takes reading the object again,
+// which only the resource can do, so the HTTP layer leaves them alone. Network errors are retried by the
+// HTTP layer only and are non-retryable here, as is any other error.
+func ClassifyError(err error) *APIError {
+	if err == nil {
+		return nil
+	}
+
+	var classified *APIError
+	if errors.As(err, &classified) {
+		return classified
+	}
+
+	classified = &APIError{Err: err}
+
+	if httpErr := extractHTTPError(err); httpErr != nil {
+		classified.StatusCode = httpErr.StatusCode
+		classified.Method = httpErr.Method
+		classified.URL = httpErr.URL
+		hint := popRequestHint(httpErr.Method, httpErr.URL)
+		classified.Retryable = httpErr.StatusCode == http.StatusConflict ||
+			(IsRetryableStatus(httpErr.Method, httpErr.StatusCode) && !hint.exhausted)
+		classified.RetryAfter = hint.retryAfter
+	}
+
+	return classified
+}
+
+// IsRetryableStatus reports whether a request with the method which failed with the status is worth
+// repeating as it is. It is the one table both the HTTP layer and ClassifyError retry by.
+// 423, 429 and 503 responses mean Jamf Pro turned the request away unprocessed, so they are retryable
+// for every method. 408, 500, 502 and 504 responses may arrive after the request took effect, so they
+// are only retryable for idempotent methods; repeating a POST or PATCH could apply it twice.
+func IsRetryableStatus(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusLocked, http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusRequestTimeout, http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return IsIdempotentMethod(method)
+	}
+
+	return false
+}
+
+// IsIdempotentMethod reports whether repeating a request with the method has the same effect as sending it once.
+func IsIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// IsTransientNetworkError reports whether err is a network level failure, such as a reset connection,
+// which a later attempt of the same request could get past.
+func IsTransientNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	message := err.Error()
+	for _, fragment := range transientNetworkErrors {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsNotFoundError reports whether err was caused by the requested object not existing in Jamf Pro.
+// This is the case for 404 and 410 responses, including the typed errors of lookups by name which matched
+// no object, and for Classic API responses carrying its not found message.
+func IsNotFoundError(err error) bool {
+	classified := ClassifyError(err)
+	if classified == nil {
+		return false
+	}
+
+	switch classified.StatusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return true
+	case 0:
+		message := classified.Error()
+		return strings.Contains(message, classicAPINotFoundMessage)
+	}
+
+	return false
+}
+
+// RetryOnError converts an SDK error into a retry.RetryError based on its classification.
+// Retryable errors honour any Retry-After delay requested by the server before being returned.
+func RetryOnError(ctx context.Context, err error) *retry.RetryError {
+	if err == nil {
+		return nil
+	}
+
+	classified := ClassifyError(err)
+	if !classified.Retryable {
+		return retry.NonRetryableError(classified)
+	}
+
+	if classified.RetryAfter > 0 {
+		timer := time.NewTimer(classified.RetryAfter)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return retry.NonRetryableError(fmt.Errorf("%v (retry after %s cancelled: %v)", classified, classified.RetryAfter, ctx.Err()))
+		case <-timer.C:
+		}
+	}
+
+	return retry.RetryableError(classified)
+}
+
+// extractHTTPError returns the http client error carried by err, either as a typed value or decoded from its message.
+func extractHTTPError(err error) *response.APIError {
+	var typed *response.APIError
+	if errors.As(err, &typed) {
+		return typed
+	}
+
+	message := err.Error()
+	start := strings.Index(message, apiErrorMarker)
+	if start == -1 {
+		return nil
+	}
+
+	var decoded response.APIError
+	if err := json.NewDecoder(strings.NewReader(message[start:])).Decode(&decoded); err != nil {
+		return nil
+	}
+
+	if decoded.StatusCode == 0 {
+		return nil
+	}
+
+	return &decoded
+}
+
+// requestHintLifetime is how long a recorded hint applies. The SDK returns the error of a request as soon as the HTTP
+// layer is done with it, so a hint is normally classified or forgotten well within this.
+const requestHintLifetime = time.Minute
+
+// requestHint is what the HTTP layer observed about the last response to a request.
+type requestHint struct {
+	retryAfter time.Duration
+	exhausted  bool
+	recordedAt time.Time
+}
+
+// requestHints holds the hints recorded by the HTTP layer, keyed by request method and URL, as that is all the SDK
+// reports of a failed request. A hint is removed when ClassifyError reads it or a later request to the same method
+// and URL succeeds. Hints which are never read, such as those of errors a resource does not classify, are dropped
+// once older than requestHintLifetime, so the map only holds the requests of the last minute. Two requests to the
+// same method and URL in flight at once share a hint.
+var requestHints = struct {
+	sync.Mutex
+	hints map[string]requestHint
+}{hints: make(map[string]requestHint)}
+
+// RecordRetryAfter stores the Retry-After delay of a response so the error classification of the
+// same request can honour it. The SDK does not surface response headers on errors.
+func RecordRetryAfter(method, url string, delay time.Duration) {
+	updateRequestHint(method, url, func(hint *requestHint) { hint.retryAfter = delay })
+}
+
+// RecordRetriesExhausted marks a request which the HTTP layer already retried as often as configured,
+// so the error classification reports it as non-retryable rather than repeating it once more.
+func RecordRetriesExhausted(method, url string) {
+	updateRequestHint(method, url, func(hint *requestHint) { hint.exhausted = true })
+}
+
+// ForgetRequestHint drops the hint recorded for a request once a request to the same method and URL
+// succeeded, so it cannot apply to a later, unrelated failure.
+func ForgetRequestHint(method, url string) {
+	requestHints.Lock()
+	defer requestHints.Unlock()
+	delete(requestHints.hints, method+" "+url)
+}
+
+// ParseRetryAfter parses a Retry-After header value given in either delay-seconds or HTTP-date form.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// popRequestHint returns and forgets the hint recorded for a request, the zero hint if it has expired.
+func popRequestHint(method, url string) requestHint {
+	requestHints.Lock()
+	defer requestHints.Unlock()
+
+	key := method + " " + url
+	hint := requestHints.hints[key]
+	delete(requestHints.hints, key)
+	if time.Since(hint.recordedAt) > requestHintLifetime {
+		return requestHint{}
+	}
+	return hint
+}
+
+// updateRequestHint applies change to the live hint recorded for a request, or to a new one, and drops every
+// expired hint.
+func updateRequestHint(method, url string, change func(*requestHint)) {
+	requestHints.Lock()
+	defer requestHints.Unlock()
+
+	now := time.Now()
+	for key, hint := range requestHints.hints {
+		if now.Sub(hint.recordedAt) > requestHintLifetime {
+			delete(requestHints.hints, key)
+		}
+	}
+
+	key := method + " " + url
+	hint := requestHints.hints[key]
+	change(&hint)
+	hint.recordedAt = now
+	requestHints.hints[key] = hint
+}
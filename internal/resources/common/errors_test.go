@@ -0,0 +1,178 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// sdkError returns an error as the SDK reports a failed request, with the http client error embedded as JSON.
+func sdkError(method string, url string, status int) error {
+	return fmt.Errorf(`failed to get resource: {"status_code":%d,"method":"%s","url":"%s","message":"%s"}`,
+		status, method, url, http.StatusText(status))
+}
+
+func TestClassifyError(t *testing.T) {
+	const url = "https://example.jamfcloud.com/api/v1/buildings/1"
+
+	for name, tc := range map[string]struct {
+		err       error
+		status    int
+		retryable bool
+	}{
+		"404":                    {sdkError(http.MethodGet, url, 404), 404, false},
+		"410":                    {sdkError(http.MethodGet, url, 410), 410, false},
+		"400":                    {sdkError(http.MethodPut, url, 400), 400, false},
+		"408 on a GET":           {sdkError(http.MethodGet, url, 408), 408, true},
+		"408 on a POST":          {sdkError(http.MethodPost, url, 408), 408, false},
+		"409 on a POST":          {sdkError(http.MethodPost, url, 409), 409, true},
+		"409 on a PUT":           {sdkError(http.MethodPut, url, 409), 409, true},
+		"423 on a POST":          {sdkError(http.MethodPost, url, 423), 423, true},
+		"429 on a POST":          {sdkError(http.MethodPost, url, 429), 429, true},
+		"500 on a GET":           {sdkError(http.MethodGet, url, 500), 500, true},
+		"500 on a POST":          {sdkError(http.MethodPost, url, 500), 500, false},
+		"500 on a PATCH":         {sdkError(http.MethodPatch, url, 500), 500, false},
+		"502 on a DELETE":        {sdkError(http.MethodDelete, url, 502), 502, true},
+		"503 on a POST":          {sdkError(http.MethodPost, url, 503), 503, true},
+		"Classic API not found":  {errors.New("failed to get policy: " + classicAPINotFoundMessage), 0, false},
+		"network error":          {errors.New(`Get "` + url + `": read: connection reset by peer`), 0, false},
+		"already classified 429": {&APIError{StatusCode: 429, Retryable: true, Err: errors.New("busy")}, 429, true},
+	} {
+		classified := ClassifyError(tc.err)
+		if classified.StatusCode != tc.status || classified.Retryable != tc.retryable {
+			t.Errorf("%s: expected status %d and retryable %t, got status %d and retryable %t",
+				name, tc.status, tc.retryable, classified.StatusCode, classified.Retryable)
+		}
+	}
+
+	if ClassifyError(nil) != nil {
+		t.Error("expected no classification for a nil error")
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	const url = "https://example.jamfcloud.com/JSSResource/policies/id/1"
+
+	for name, tc := range map[string]struct {
+		err      error
+		notFound bool
+	}{
+		"404":                   {sdkError(http.MethodGet, url, 404), true},
+		"410":                   {sdkError(http.MethodGet, url, 410), true},
+		"Classic API not found": {errors.New("failed to get policy: " + classicAPINotFoundMessage), true},
+		"400":                   {sdkError(http.MethodGet, url, 400), false},
+		"500":                   {sdkError(http.MethodGet, url, 500), false},
+		"network error":         {errors.New("dial tcp: connection refused"), false},
+		"nil":                   {nil, false},
+	} {
+		if got := IsNotFoundError(tc.err); got != tc.notFound {
+			t.Errorf("%s: expected not found to be %t, got %t", name, tc.notFound, got)
+		}
+	}
+}
+
+func TestRetryOnError(t *testing.T) {
+	const url = "https://example.jamfcloud.com/api/v1/scripts/1"
+	ctx := context.Background()
+
+	if RetryOnError(ctx, nil) != nil {
+		t.Fatal("expected no retry error for a nil error")
+	}
+	if retryErr := RetryOnError(ctx, sdkError(http.MethodGet, url, 503)); retryErr == nil || !retryErr.Retryable {
+		t.Fatalf("expected a 503 to be retried, got %+v", retryErr)
+	}
+	if retryErr := RetryOnError(ctx, sdkError(http.MethodGet, url, 400)); retryErr == nil || retryErr.Retryable {
+		t.Fatalf("expected a 400 not to be retried, got %+v", retryErr)
+	}
+
+	// A recorded Retry-After delay is waited out before the error is returned.
+	RecordRetryAfter(http.MethodGet, url, 50*time.Millisecond)
+	start := time.Now()
+	if retryErr := RetryOnError(ctx, sdkError(http.MethodGet, url, 429)); retryErr == nil || !retryErr.Retryable {
+		t.Fatalf("expected a 429 to be retried, got %+v", retryErr)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("expected the Retry-After delay to be waited out, waited %s", elapsed)
+	}
+
+	// The wait stops when the context is done, and the error is no longer retried.
+	RecordRetryAfter(http.MethodGet, url, time.Minute)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if retryErr := RetryOnError(cancelled, sdkError(http.MethodGet, url, 429)); retryErr == nil || retryErr.Retryable {
+		t.Fatalf("expected a cancelled wait not to be retried, got %+v", retryErr)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		value string
+		delay time.Duration
+		ok    bool
+	}{
+		"seconds":        {"120", 2 * time.Minute, true},
+		"zero seconds":   {"0", 0, true},
+		"padded seconds": {" 5 ", 5 * time.Second, true},
+		"future date":    {now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		"past date":      {now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		"empty":          {"", 0, false},
+		"negative":       {"-1", 0, false},
+		"garbage":        {"soon", 0, false},
+	} {
+		delay, ok := ParseRetryAfter(tc.value, now)
+		if delay != tc.delay || ok != tc.ok {
+			t.Errorf("%s: expected %s and %t, got %s and %t", name, tc.delay, tc.ok, delay, ok)
+		}
+	}
+}
+
+func TestRequestHintLifecycle(t *testing.T) {
+	const url = "https://example.jamfcloud.com/api/v1/departments/1"
+
+	// A hint applies to the next classification of the request only.
+	RecordRetryAfter(http.MethodGet, url, 30*time.Second)
+	if delay := ClassifyError(sdkError(http.MethodGet, url, 429)).RetryAfter; delay != 30*time.Second {
+		t.Fatalf("expected the recorded Retry-After of 30s, got %s", delay)
+	}
+	if delay := ClassifyError(sdkError(http.MethodGet, url, 429)).RetryAfter; delay != 0 {
+		t.Fatalf("expected the hint to be consumed, got a Retry-After of %s", delay)
+	}
+
+	// Requests the HTTP layer gave up on are not retried again, and only the method and URL recorded are affected.
+	RecordRetriesExhausted(http.MethodGet, url)
+	if !ClassifyError(sdkError(http.MethodPut, url, 503)).Retryable {
+		t.Fatal("expected a PUT to the same URL to keep its own classification")
+	}
+	if ClassifyError(sdkError(http.MethodGet, url, 503)).Retryable {
+		t.Fatal("expected a request whose retries were exhausted to be non-retryable")
+	}
+
+	// A success forgets the hint.
+	RecordRetriesExhausted(http.MethodGet, url)
+	ForgetRequestHint(http.MethodGet, url)
+	if !ClassifyError(sdkError(http.MethodGet, url, 503)).Retryable {
+		t.Fatal("expected a forgotten hint not to apply")
+	}
+
+	// Hints never read expire, and are dropped when the next hint is recorded.
+	RecordRetriesExhausted(http.MethodGet, url)
+	requestHints.Lock()
+	stale := requestHints.hints[http.MethodGet+" "+url]
+	stale.recordedAt = time.Now().Add(-2 * requestHintLifetime)
+	requestHints.hints[http.MethodGet+" "+url] = stale
+	requestHints.Unlock()
+
+	RecordRetryAfter(http.MethodDelete, url, time.Second)
+	requestHints.Lock()
+	_, kept := requestHints.hints[http.MethodGet+" "+url]
+	requestHints.Unlock()
+	if kept {
+		t.Fatal("expected the expired hint to be dropped")
+	}
+	ForgetRequestHint(http.MethodDelete, url)
+}
@@ -0,0 +1,61 @@
+// common/extensionattributes/inputtype.go
+// This package holds the input type handling shared by the computer and mobile device extension attribute resources
+package extensionattributes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Input types of extension attributes, as named by the Classic API.
+const (
+	InputTypeTextField        = "Text Field"
+	InputTypePopupMenu        = "Pop-up Menu"
+	InputTypeScript           = "script"
+	InputTypeDirectoryMapping = "LDAP Attribute Mapping"
+)
+
+// Inputs are the settings of an extension attribute which populate its value, each only valid with one input type.
+type Inputs struct {
+	// Type is the configured input type.
+	Type string
+	// PopupChoices are the choices of a pop-up menu.
+	PopupChoices []string
+	// Script is the script populating the attribute on computers.
+	Script string
+	// DirectoryMapping is the directory service attribute the value is mapped from.
+	DirectoryMapping string
+}
+
+// ValidateInputs reports an error when an input is set which the input type does not use, or when a pop-up menu has
+// no choices. Input types are compared case insensitively, as the schema accepts them in any case.
+func ValidateInputs(inputs Inputs) error {
+	for _, input := range []struct {
+		set       bool
+		attribute string
+		inputType string
+	}{
+		{len(inputs.PopupChoices) != 0, "input_popup", InputTypePopupMenu},
+		{inputs.Script != "", "input_script", InputTypeScript},
+		{inputs.DirectoryMapping != "", "input_directory_mapping", InputTypeDirectoryMapping},
+	} {
+		if input.set && !strings.EqualFold(inputs.Type, input.inputType) {
+			return fmt.Errorf("%s can only be set when input_type is '%s', got '%s'", input.attribute, input.inputType, inputs.Type)
+		}
+	}
+
+	if strings.EqualFold(inputs.Type, InputTypePopupMenu) && len(inputs.PopupChoices) == 0 {
+		return fmt.Errorf("input_popup must list at least one choice when input_type is '%s'", InputTypePopupMenu)
+	}
+
+	return nil
+}
+
+// PopupChoices converts the input_popup list of the schema to its choices.
+func PopupChoices(list []interface{}) []string {
+	choices := make([]string, 0, len(list))
+	for _, choice := range list {
+		choices = append(choices, choice.(string))
+	}
+	return choices
+}
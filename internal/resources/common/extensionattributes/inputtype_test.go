@@ -0,0 +1,23 @@
+package extensionattributes
+
+import "testing"
+
+func TestValidateInputs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		inputs Inputs
+		valid  bool
+	}{
+		"text field":                  {Inputs{Type: InputTypeTextField}, true},
+		"pop-up menu":                 {Inputs{Type: "pop-up menu", PopupChoices: []string{"one"}}, true},
+		"pop-up menu without choices": {Inputs{Type: InputTypePopupMenu}, false},
+		"script":                      {Inputs{Type: InputTypeScript, Script: "#!/bin/sh"}, true},
+		"directory mapping":           {Inputs{Type: InputTypeDirectoryMapping, DirectoryMapping: "department"}, true},
+		"choices of a text field":     {Inputs{Type: InputTypeTextField, PopupChoices: []string{"one"}}, false},
+		"script of a pop-up menu":     {Inputs{Type: InputTypePopupMenu, PopupChoices: []string{"one"}, Script: "#!/bin/sh"}, false},
+		"mapping of a script":         {Inputs{Type: InputTypeScript, DirectoryMapping: "department"}, false},
+	} {
+		if err := ValidateInputs(tc.inputs); (err == nil) != tc.valid {
+			t.Errorf("%s: expected valid to be %v, got error %v", name, tc.valid, err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// common/externaltransport/transport.go
+// This package lets resources reach servers other than Jamf Pro, such as package download hosts, with the proxy and
+// certificate authorities configured for the provider instance they belong to
+package externaltransport
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// transports hold the transport for servers other than Jamf Pro of each configured client, keyed by the provider meta.
+var (
+	transports   = make(map[*jamfpro.Client]*http.Transport)
+	transportsMu sync.RWMutex
+)
+
+// Register records the transport used to reach servers other than Jamf Pro by the provider instance with the given
+// client. It must not present the client certificate meant for Jamf Pro. The provider calls it once configured.
+func Register(client *jamfpro.Client, transport *http.Transport) {
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	transports[client] = transport
+}
+
+// Clone returns a copy of the transport registered for the provider meta, which the caller may adjust, or a copy of
+// the default transport of net/http if none is registered.
+func Clone(meta interface{}) *http.Transport {
+	if client, ok := meta.(*jamfpro.Client); ok {
+		transportsMu.RLock()
+		transport, ok := transports[client]
+		transportsMu.RUnlock()
+		if ok {
+			return transport.Clone()
+		}
+	}
+
+	return http.DefaultTransport.(*http.Transport).Clone()
+}
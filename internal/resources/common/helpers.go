@@ -7,8 +7,8 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
-	"log"
 	"reflect"
+	"sort"
 	"strconv"
 )
 
@@ -16,9 +16,7 @@ import (
 func HashString(s string) string {
 	h := sha256.New()
 	h.Write([]byte(s))
-	hash := fmt.Sprintf("%x", h.Sum(nil))
-	log.Printf("Computed hash: %s", hash)
-	return hash
+	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
 // SerializeAndRedactXML serializes a resource to XML and redacts specified fields.
@@ -92,3 +90,31 @@ func getIDField(response interface{}) (any, error) {
 
 	return nil, fmt.Errorf("unsupported type")
 }
+
+// DiffStringSets returns the values of want missing from have, and those of have missing from want, each sorted
+// and listed once.
+func DiffStringSets(have, want []string) (add, remove []string) {
+	inHave := make(map[string]bool, len(have))
+	for _, value := range have {
+		inHave[value] = true
+	}
+	inWant := make(map[string]bool, len(want))
+	for _, value := range want {
+		inWant[value] = true
+	}
+
+	for value := range inWant {
+		if !inHave[value] {
+			add = append(add, value)
+		}
+	}
+	for value := range inHave {
+		if !inWant[value] {
+			remove = append(remove, value)
+		}
+	}
+
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffStringSets(t *testing.T) {
+	for name, tc := range map[string]struct {
+		have, want  []string
+		add, remove []string
+	}{
+		"both empty":  {nil, nil, nil, nil},
+		"have empty":  {nil, []string{"B", "A"}, []string{"A", "B"}, nil},
+		"want empty":  {[]string{"B", "A"}, []string{}, nil, []string{"A", "B"}},
+		"equal":       {[]string{"A", "B"}, []string{"B", "A"}, nil, nil},
+		"overlapping": {[]string{"A", "B", "C"}, []string{"D", "B", "A"}, []string{"D"}, []string{"C"}},
+		"disjoint":    {[]string{"A"}, []string{"B"}, []string{"B"}, []string{"A"}},
+		"duplicates":  {[]string{"A", "C", "C"}, []string{"B", "B", "A"}, []string{"B"}, []string{"C"}},
+	} {
+		add, remove := DiffStringSets(tc.have, tc.want)
+		if !reflect.DeepEqual(add, tc.add) || !reflect.DeepEqual(remove, tc.remove) {
+			t.Errorf("%s: expected to add %v and remove %v, got %v and %v", name, tc.add, tc.remove, add, remove)
+		}
+	}
+}
@@ -2,6 +2,8 @@
 // This package contains shared / common resource variables
 package jamfprivileges
 
+import "github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfversion"
+
 // TODO this should be in a JSON file and updated automatically
 
 // ValidJSSObjectsPrivileges contains a list of all valid values for JSS Object Priviledges field. Used by
@@ -526,3 +528,10 @@ var ValidCasperAdminPrivileges = []string{
 	"Use Casper Admin",
 	"Save With Casper Admin",
 }
+
+// CasperAdminPrivilegesGate restricts the Casper Admin privileges of accounts and account groups to the releases
+// before Jamf Pro 11.6, which removed them along with Casper Admin.
+var CasperAdminPrivilegesGate = jamfversion.AttributeGate{
+	Attribute: "casper_admin_privileges",
+	RemovedIn: jamfversion.MustParse("11.6.0"),
+}
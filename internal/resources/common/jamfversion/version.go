@@ -0,0 +1,164 @@
+// common/jamfversion/version.go
+// This package tracks the version of the connected Jamf Pro server so resources can gate attributes and validations
+package jamfversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a Jamf Pro release. The build suffix Jamf Pro reports, e.g. "-t1714996541", is not part of it.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// Parse reads a version as reported by Jamf Pro, e.g. "11.5.0-t1714996541". A missing minor or patch number is 0.
+func Parse(raw string) (Version, error) {
+	core, _, _ := strings.Cut(strings.TrimSpace(raw), "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid Jamf Pro version %q: expected major.minor.patch", raw)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return Version{}, fmt.Errorf("invalid Jamf Pro version %q: expected major.minor.patch", raw)
+		}
+		numbers[i] = number
+	}
+
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// MustParse is Parse for versions known at compile time. It panics if raw is invalid.
+func MustParse(raw string) Version {
+	v, err := Parse(raw)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the version as major.minor.patch.
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or 1 as v is older than, the same as or newer than other.
+func (v Version) Compare(other Version) int {
+	for _, pair := range [][2]int{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] < pair[1] {
+			return -1
+		}
+		if pair[0] > pair[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is the same as or newer than minimum.
+func (v Version) AtLeast(minimum Version) bool {
+	return v.Compare(minimum) >= 0
+}
+
+// Server is the connected Jamf Pro server as read when the provider was configured.
+type Server struct {
+	Version Version
+	// Reported is the version as Jamf Pro reports it, including its build, e.g. "11.5.0-t1714996541".
+	Reported string
+}
+
+// serverKey is the context key of the Server behind a provider configuration.
+type serverKey struct{}
+
+// WithServer returns ctx holding the server the provider configuration is connected to. The provider attaches it
+// to the context of every resource and data source function once configured.
+func WithServer(ctx context.Context, server Server) context.Context {
+	return context.WithValue(ctx, serverKey{}, server)
+}
+
+// ServerFromContext returns the server held by ctx, and false if it is not known, e.g. because the version could
+// not be read when the provider was configured.
+func ServerFromContext(ctx context.Context) (Server, bool) {
+	server, ok := ctx.Value(serverKey{}).(Server)
+	return server, ok
+}
+
+// ServerVersion returns the version of the server held by ctx, and false if it is not known.
+func ServerVersion(ctx context.Context) (Version, bool) {
+	server, ok := ServerFromContext(ctx)
+	return server.Version, ok
+}
+
+// ServerAtLeast reports whether the server held by ctx is known to run minimum or newer.
+func ServerAtLeast(ctx context.Context, minimum Version) bool {
+	v, ok := ServerVersion(ctx)
+	return ok && v.AtLeast(minimum)
+}
+
+// AttributeGate restricts an attribute to the Jamf Pro releases supporting it. A zero Introduced or RemovedIn
+// leaves that end of the range open.
+type AttributeGate struct {
+	Attribute  string
+	Introduced Version
+	RemovedIn  Version
+}
+
+// Supports reports whether the attribute is supported by Jamf Pro v.
+func (g AttributeGate) Supports(v Version) bool {
+	if !v.AtLeast(g.Introduced) {
+		return false
+	}
+	return g.RemovedIn == (Version{}) || !v.AtLeast(g.RemovedIn)
+}
+
+// attributeGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type attributeGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+/*
+ValidateAttributes checks the attributes set in a resource configuration against the version of the connected
+server. Resources call it from their CustomizeDiff, so unsupported attributes fail the plan rather than the apply.
+
+Parameters:
+
+	ctx   - The context the CustomizeDiff is called with, holding the connected server.
+	d     - The resource diff holding the configuration.
+	gates - The version-dependent attributes of the resource.
+
+Returns:
+
+	An error naming each attribute set which the server does not accept. Nothing is checked if the server version
+	is not known.
+*/
+func ValidateAttributes(ctx context.Context, d attributeGetter, gates []AttributeGate) error {
+	v, ok := ServerVersion(ctx)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, gate := range gates {
+		if _, set := d.GetOk(gate.Attribute); !set || gate.Supports(v) {
+			continue
+		}
+
+		if gate.RemovedIn != (Version{}) && v.AtLeast(gate.RemovedIn) {
+			errs = append(errs, fmt.Errorf("%s is not supported by the connected server, which runs Jamf Pro %s: it was removed in Jamf Pro %s. Remove it from the configuration", gate.Attribute, v, gate.RemovedIn))
+		} else {
+			errs = append(errs, fmt.Errorf("%s is not supported by the connected server, which runs Jamf Pro %s: it was introduced in Jamf Pro %s", gate.Attribute, v, gate.Introduced))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,85 @@
+package jamfversion
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := map[string]Version{
+		"11.5.0-t1714996541": {Major: 11, Minor: 5, Patch: 0},
+		"10.49.1":            {Major: 10, Minor: 49, Patch: 1},
+		"11.6":               {Major: 11, Minor: 6},
+	}
+	for raw, want := range cases {
+		got, err := Parse(raw)
+		if err != nil || got != want {
+			t.Errorf("Parse(%q) = %v, %v; want %v", raw, got, err, want)
+		}
+	}
+
+	for _, raw := range []string{"", "eleven", "11.5.0.1", "11.-5.0"} {
+		if _, err := Parse(raw); err == nil {
+			t.Errorf("expected Parse(%q) to fail", raw)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	older, newer := MustParse("11.5.1"), MustParse("11.10.0")
+
+	if older.Compare(newer) != -1 || newer.Compare(older) != 1 || older.Compare(older) != 0 {
+		t.Fatalf("expected %s to be older than %s", older, newer)
+	}
+	if !newer.AtLeast(older) || older.AtLeast(newer) {
+		t.Fatalf("expected only %s to be at least %s", newer, older)
+	}
+}
+
+func TestAttributeGateSupports(t *testing.T) {
+	gate := AttributeGate{Attribute: "example", Introduced: MustParse("11.2.0"), RemovedIn: MustParse("11.6.0")}
+
+	for raw, want := range map[string]bool{"11.1.9": false, "11.2.0": true, "11.5.1": true, "11.6.0": false} {
+		if got := gate.Supports(MustParse(raw)); got != want {
+			t.Errorf("Supports(%s) = %t, want %t", raw, got, want)
+		}
+	}
+}
+
+// configuration is an attributeGetter over fixed attribute values.
+type configuration map[string]interface{}
+
+func (c configuration) GetOk(key string) (interface{}, bool) {
+	v, ok := c[key]
+	return v, ok
+}
+
+func TestValidateAttributes(t *testing.T) {
+	ctx := context.Background()
+	gates := []AttributeGate{
+		{Attribute: "retired", RemovedIn: MustParse("11.6.0")},
+		{Attribute: "recent", Introduced: MustParse("11.6.0")},
+	}
+	config := configuration{"retired": "value"}
+
+	if err := ValidateAttributes(ctx, config, gates); err != nil {
+		t.Fatalf("expected nothing to be checked while the server version is unknown, got %v", err)
+	}
+
+	older := WithServer(ctx, Server{Version: MustParse("11.5.0"), Reported: "11.5.0-t1714996541"})
+	if err := ValidateAttributes(older, config, gates); err != nil {
+		t.Fatalf("expected no error before the attribute was removed, got %v", err)
+	}
+	if err := ValidateAttributes(older, configuration{"recent": "value"}, gates); err == nil || !strings.Contains(err.Error(), "introduced in Jamf Pro 11.6.0") {
+		t.Fatalf("expected an error that the attribute was introduced later, got %v", err)
+	}
+
+	newer := WithServer(ctx, Server{Version: MustParse("11.6.1"), Reported: "11.6.1-t1718045462"})
+	if err := ValidateAttributes(newer, config, gates); err == nil || !strings.Contains(err.Error(), "removed in Jamf Pro 11.6.0") {
+		t.Fatalf("expected an error that the attribute was removed, got %v", err)
+	}
+	if err := ValidateAttributes(newer, configuration{}, gates); err != nil {
+		t.Fatalf("expected no error for attributes which are not set, got %v", err)
+	}
+}
@@ -4,8 +4,6 @@
 package common
 
 import (
-	"strings"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,9 +11,7 @@ import (
 // HandleResourceNotFoundError is a helper function to handle 404 and 410 errors and remove the resource from Terraform state
 func HandleResourceNotFoundError(err error, d *schema.ResourceData, cleanup bool) diag.Diagnostics {
 	var diags diag.Diagnostics
-	ErrorTypeIsNotFound := strings.Contains(err.Error(), "404")
-
-	if cleanup && ErrorTypeIsNotFound {
+	if cleanup && IsNotFoundError(err) {
 		d.SetId("")
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Warning,
@@ -2,10 +2,11 @@
 package sharedschemas
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"reflect"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -53,7 +54,7 @@ func ExtractNestedObjectsFromSchema[NestedObjectType any, ListItemPrimitiveType
 	if len(outList) > 0 {
 		*home = outList
 	} else {
-		log.Println("list is empty")
+		logging.Debugf(context.Background(), logging.SubsystemCRUD, "list is empty")
 	}
 
 	return nil
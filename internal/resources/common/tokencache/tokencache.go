@@ -0,0 +1,41 @@
+// common/tokencache/tokencache.go
+// This package lets resources invalidate the access tokens cached for the provider instance they belong to
+package tokencache
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// invalidators hold the function invalidating the cached tokens of each configured client, keyed by the provider meta.
+var (
+	invalidators   = make(map[*jamfpro.Client]func() error)
+	invalidatorsMu sync.RWMutex
+)
+
+// Register records how the cached tokens of the provider instance with the given client are invalidated. The
+// provider calls it once configured.
+func Register(client *jamfpro.Client, invalidate func() error) {
+	invalidatorsMu.Lock()
+	defer invalidatorsMu.Unlock()
+	invalidators[client] = invalidate
+}
+
+// Invalidate drops the cached tokens of the provider instance behind the provider meta and revokes them on Jamf Pro.
+func Invalidate(meta interface{}) error {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return fmt.Errorf("unexpected provider meta %T", meta)
+	}
+
+	invalidatorsMu.RLock()
+	invalidate, ok := invalidators[client]
+	invalidatorsMu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	return invalidate()
+}
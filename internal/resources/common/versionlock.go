@@ -0,0 +1,73 @@
+// common/versionlock.go
+// This package contains shared / common functions for updating Jamf Pro API objects guarded by optimistic locking
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// versionLockCopyFunc copies the version locks of the current object onto the payload replacing it.
+type versionLockCopyFunc[PayloadType any] func(payload, current *PayloadType)
+
+/*
+UpdateWithVersionLock updates an object which Jamf Pro guards with a versionLock, such as a prestage enrollment.
+
+Jamf Pro rejects an update with a 409 unless it carries the version lock of the stored object, which changes with
+every update, including those made in the GUI. The version lock of the state is stale as soon as anyone else edits
+the object, so before each attempt the object is read and its version locks copied onto the payload. A 409 caused by
+a concurrent change is retried, with the version lock read again, until the update timeout.
+*/
+func UpdateWithVersionLock[PayloadType any, ResponseType any](
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+	constructor payoadConstructorFunc[PayloadType],
+	getCurrent sdkGetFunc[PayloadType],
+	copyVersionLocks versionLockCopyFunc[PayloadType],
+	outcomeFunc sdkUpdateFunc[PayloadType, ResponseType],
+	reader providerReadFunc,
+) diag.Diagnostics {
+	resourceID := d.Id()
+
+	payload, err := constructor(ctx, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct %T for update: %v", payload, err))
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+		current, apiErr := getCurrent(resourceID)
+		if apiErr != nil {
+			return RetryOnError(ctx, apiErr)
+		}
+		copyVersionLocks(payload, current)
+
+		_, apiErr = outcomeFunc(resourceID, payload)
+		return RetryOnError(ctx, apiErr)
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro %T (ID: %s) after retries: %v", payload, resourceID, err))
+	}
+
+	return reader(ctx, d, meta)
+}
+
+// RetryWithVersionLock calls attempt with the version lock returned by getVersionLock, reading it again before each
+// retry, so a change guarded by a version lock of its own, such as the scope of a prestage, survives concurrent edits.
+func RetryWithVersionLock(ctx context.Context, timeout time.Duration, getVersionLock func() (int, error), attempt func(versionLock int) error) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		versionLock, err := getVersionLock()
+		if err != nil {
+			return RetryOnError(ctx, err)
+		}
+
+		return RetryOnError(ctx, attempt(versionLock))
+	})
+}
@@ -2,16 +2,17 @@
 package computercheckin
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProComputerCheckin constructs a ResourceComputerCheckin object from the provided schema data and logs its XML representation.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerCheckin, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerCheckin, error) {
 	resource := &jamfpro.ResourceComputerCheckin{
 		CheckInFrequency:          d.Get("check_in_frequency").(int),
 		CreateStartupScript:       d.Get("create_startup_script").(bool),
@@ -31,7 +32,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerCheckin, error)
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Checkin to XML: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Checkin XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Computer Checkin XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
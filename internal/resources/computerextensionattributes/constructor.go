@@ -2,16 +2,19 @@
 package computerextensionattributes
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
+	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/extensionattributes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProComputerExtensionAttribute constructs a ResourceComputerExtensionAttribute object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttribute, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttribute, error) {
 	resource := &jamfpro.ResourceComputerExtensionAttribute{
 		Name:             d.Get("name").(string),
 		Enabled:          d.Get("enabled").(bool),
@@ -21,38 +24,22 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttrib
 		ReconDisplay:     d.Get("recon_display").(string),
 	}
 
-	inputTypeEnabledText := d.Get("input_type").(string) == "Text Field"
-	inputTypeEnabledPopUp := len(d.Get("input_popup").([]interface{})) != 0
-	inputTypeEnabledScript := d.Get("input_script") != ""
-	// inputTypeEnabledDirectory := d.Get("input_directory_mapping") != ""
-
-	log.Printf("Text: %v", d.Get("input_type").(string))
-	log.Printf("Popup: %v", d.Get("input_popup"))
-	log.Printf("script: %v", d.Get("input_script"))
-	log.Printf("Dir: %v", d.Get("input_directory_mapping"))
-
-	inputList := []bool{inputTypeEnabledText, inputTypeEnabledPopUp, inputTypeEnabledScript}
-	var boolCount int
-	for _, v := range inputList {
-		if v {
-			boolCount += 1
-		}
+	inputs := extensionattributes.Inputs{
+		Type:         d.Get("input_type").(string),
+		PopupChoices: extensionattributes.PopupChoices(d.Get("input_popup").([]interface{})),
+		Script:       d.Get("input_script").(string),
 	}
-	if boolCount > 1 {
-		return nil, fmt.Errorf("multiple input types selected, please adjust your configuratuon")
+	if err := extensionattributes.ValidateInputs(inputs); err != nil {
+		return nil, err
 	}
 
-	inputType := d.Get("input_type").(string)
-	resource.InputType.Type = inputType
+	resource.InputType.Type = inputs.Type
 
-	if inputType == "Pop-up Menu" {
-		choices := d.Get("input_popup").([]interface{})
-		for _, v := range choices {
-			resource.InputType.Choices = append(resource.InputType.Choices, v.(string))
-		}
-	} else if inputType == "script" {
+	if strings.EqualFold(inputs.Type, extensionattributes.InputTypePopupMenu) {
+		resource.InputType.Choices = inputs.PopupChoices
+	} else if strings.EqualFold(inputs.Type, extensionattributes.InputTypeScript) {
 		resource.InputType.Platform = "Mac"
-		resource.InputType.Script = d.Get("input_script").(string)
+		resource.InputType.Script = inputs.Script
 	}
 
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
@@ -60,7 +47,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttrib
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Extension Attribute '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Extension Attribute XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Computer Extension Attribute XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/extensionattributes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -61,7 +62,7 @@ func ResourceJamfProComputerExtensionAttributes() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				Description:  "Extension Attribute Input Type",
-				ValidateFunc: validation.StringInSlice([]string{"script", "Text Field", "Pop-up Menu"}, true),
+				ValidateFunc: validation.StringInSlice([]string{extensionattributes.InputTypeScript, extensionattributes.InputTypeTextField, extensionattributes.InputTypePopupMenu}, true),
 			},
 			"input_popup": {
 				Type:        schema.TypeList,
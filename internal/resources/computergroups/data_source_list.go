@@ -0,0 +1,90 @@
+// computergroups_data_source_list.go
+package computergroups
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the computer groups list data source, which covers both smart and static groups.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "computer group",
+	Site:             true,
+	FilterOnly:       true,
+	Attributes: map[string]*schema.Schema{
+		"is_smart": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the computer group is a smart group rather than a static group.",
+		},
+	},
+}
+
+// DataSourceJamfProComputerGroupsList provides the smart and static computer groups in Jamf Pro,
+// optionally filtered by name or site.
+func DataSourceJamfProComputerGroupsList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the computer groups in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		func(item *common.ListItem) error {
+			return describeItem(client, item)
+		},
+	)
+}
+
+// listItems returns all computer groups in Jamf Pro as list items.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetComputerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.Results))
+	for _, item := range response.Results {
+		items = append(items, common.ListItem{
+			ID:   strconv.Itoa(item.ID),
+			Name: item.Name,
+			Attributes: map[string]interface{}{
+				"is_smart": item.IsSmart,
+			},
+		})
+	}
+
+	return items, nil
+}
+
+// describeItem completes a computer group list item with its site, which the list endpoint omits.
+func describeItem(client *jamfpro.Client, item *common.ListItem) error {
+	resource, err := client.GetComputerGroupByID(item.ID)
+	if err != nil {
+		return err
+	}
+
+	if resource.Site != nil {
+		item.Site = resource.Site.Name
+	}
+
+	return nil
+}
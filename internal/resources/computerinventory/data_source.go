@@ -16,8 +16,18 @@ func DataSourceJamfProComputerInventory() *schema.Resource {
 		ReadContext: dataSourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the computer. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the computer. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"udid": {
 				Type:     schema.TypeString,
@@ -1327,6 +1337,31 @@ func DataSourceJamfProComputerInventory() *schema.Resource {
 	}
 }
 
+// getComputerInventoryByName returns the inventory of the only computer with the given name.
+// The full inventory list is fetched once, so that ambiguous names can be reported.
+func getComputerInventoryByName(client *jamfpro.Client, name string) (*jamfpro.ResourceComputerInventory, error) {
+	inventories, err := client.GetComputersInventory("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list computer inventories: %v", err)
+	}
+
+	var matches []jamfpro.ResourceComputerInventory
+	for _, inventory := range inventories.Results {
+		if inventory.General.Name == name {
+			matches = append(matches, inventory)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no Jamf Pro computer found with name '%s'", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d Jamf Pro computers found with name '%s', use 'id' to select one", len(matches), name)
+	}
+}
+
 // dataSourceRead fetches the details of a specific macOS Configuration Profile
 // from Jamf Pro using either its unique Name or its ID. The function prioritizes the 'name' attribute over the 'id'
 // attribute for fetching details. If neither 'name' nor 'id' is provided, it returns an error.
@@ -1342,29 +1377,24 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{
 	var err error
 
 	// Fetch profile by 'name' or 'id'
-	if v, ok := d.GetOk("name"); ok {
-		profileName, ok := v.(string)
-		if !ok {
-			return diag.Errorf("error asserting 'name' as string")
+	if v, ok := d.GetOk("id"); ok && v.(string) != "" {
+		profile, err = client.GetComputerInventoryByID(v.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to fetch computer inventory with ID '%s': %v", v.(string), err))
 		}
-		profile, err = client.GetComputerInventoryByName(profileName)
-	} else if v, ok := d.GetOk("id"); ok {
-		profileID, ok := v.(string)
-		if !ok {
-			return diag.Errorf("error asserting 'id' as string")
+	} else if v, ok := d.GetOk("name"); ok && v.(string) != "" {
+		profile, err = getComputerInventoryByName(client, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		profile, err = client.GetComputerInventoryByID(profileID)
 	} else {
 		return diag.Errorf("Either 'name' or 'id' must be provided")
 	}
 
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to fetch macOS Configuration Profile: %v", err))
-	}
-
 	// Set top-level attributes
 	d.SetId(profile.ID)
 	d.Set("id", profile.ID)
+	d.Set("name", profile.General.Name)
 	d.Set("udid", profile.UDID)
 
 	// Set 'general' section
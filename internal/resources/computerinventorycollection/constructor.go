@@ -2,16 +2,17 @@
 package computerinventorycollection
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProComputerInventoryCollection constructs a ResourceComputerInventoryCollection object from the provided schema data and logs its XML representation.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerInventoryCollection, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerInventoryCollection, error) {
 	resource := &jamfpro.ResourceComputerInventoryCollection{
 		LocalUserAccounts:             d.Get("local_user_accounts").(bool),
 		HomeDirectorySizes:            d.Get("home_directory_sizes").(bool),
@@ -75,7 +76,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerInventoryCollec
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Inventory Collection to XML: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Inventory Collection XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Computer Inventory Collection XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
@@ -16,7 +16,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, err := construct(d)
+	resource, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Inventory Collection for update: %v", err))
 	}
@@ -24,7 +24,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		apiErr := client.UpdateComputerInventoryCollectionInformation(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -50,7 +50,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup
 		var apiErr error
 		response, apiErr = client.GetComputerInventoryCollectionInformation()
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -77,7 +77,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	inventoryCollectionConfig, err := construct(d)
+	inventoryCollectionConfig, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Inventory Collection for update: %v", err))
 	}
@@ -85,7 +85,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		apiErr := client.UpdateComputerInventoryCollectionInformation(inventoryCollectionConfig)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -2,16 +2,17 @@
 package computerprestageenrollments
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProComputerPrestageEnrollment constructs a ResourceComputerPrestage object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerPrestage, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerPrestage, error) {
 	resource := &jamfpro.ResourceComputerPrestage{
 		DisplayName:                     d.Get("display_name").(string),
 		Mandatory:                       d.Get("mandatory").(bool),
@@ -101,7 +102,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerPrestage, error
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Prestage Enrollment '%s' to XML: %v", resource.DisplayName, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Prestage Enrollment XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Computer Prestage Enrollment XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
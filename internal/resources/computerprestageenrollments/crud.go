@@ -43,18 +43,31 @@ func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}
 	return read(ctx, d, meta, false)
 }
 
-// update is responsible for updating an existing Jamf Pro Department on the remote system.
+// update is responsible for updating an existing Jamf Pro Computer Prestage on the remote system, against the
+// version lock it holds at the time.
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return common.Update(
+	client := meta.(*jamfpro.Client)
+
+	return common.UpdateWithVersionLock(
 		ctx,
 		d,
 		meta,
 		construct,
-		meta.(*jamfpro.Client).UpdateComputerPrestageByID,
+		client.GetComputerPrestageByID,
+		copyVersionLocks,
+		client.UpdateComputerPrestageByID,
 		readNoCleanup,
 	)
 }
 
+// copyVersionLocks copies the version locks of the stored prestage and of its nested settings onto an update.
+func copyVersionLocks(payload, current *jamfpro.ResourceComputerPrestage) {
+	payload.VersionLock = current.VersionLock
+	payload.LocationInformation.VersionLock = current.LocationInformation.VersionLock
+	payload.PurchasingInformation.VersionLock = current.PurchasingInformation.VersionLock
+	payload.AccountSettings.VersionLock = current.AccountSettings.VersionLock
+}
+
 // delete is responsible for deleting a Jamf Pro Computer Prestage.
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return common.Delete(
@@ -3,12 +3,11 @@ package computerprestageenrollments
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,47 +20,63 @@ func DataSourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the computer prestage.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the computer prestage enrollment. Conflicts with `display_name`.",
+				ExactlyOneOf: []string{"id", "display_name"},
 			},
 			"display_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The display name of the computer prestage.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique display name of the computer prestage enrollment. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "display_name"},
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific department from Jamf Pro using its unique ID.
+// dataSourceRead fetches the details of a specific computer prestage enrollment from Jamf Pro using either its unique display name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
-
-	var resource *jamfpro.ResourceComputerPrestage
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = client.GetComputerPrestageByID(resourceID)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Computer Prestage Enrollment",
+		"display_name",
+		client.GetComputerPrestageByID,
+		client.GetComputerPrestageByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
 
+// listNames returns the display names of all computer prestage enrollments in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetComputerPrestages("")
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro computer prestage enrollment with ID '%s' after retries: %v", resourceID, err))
+		return nil, err
+	}
+
+	names := make([]string, 0, len(response.Results))
+	for _, item := range response.Results {
+		names = append(names, item.DisplayName)
 	}
 
-	if resource != nil {
-		d.SetId(resourceID)
-		if err := d.Set("display_name", resource.DisplayName); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'display_name' for Jamf Pro computer prestage enrollment with ID '%s': %v", resourceID, err))...)
-		}
-	} else {
-		d.SetId("")
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the computer prestage enrollment returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceComputerPrestage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(resource.ID)
+	if err := d.Set("display_name", resource.DisplayName); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
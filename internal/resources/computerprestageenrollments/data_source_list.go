@@ -0,0 +1,75 @@
+// computerprestageenrollments_data_source_list.go
+package computerprestageenrollments
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the computer prestage enrollments list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "computer prestage enrollment",
+	Site:             true,
+}
+
+// DataSourceJamfProComputerPrestageEnrollmentsList provides the computer prestage enrollments in Jamf Pro,
+// optionally filtered by display name or site.
+func DataSourceJamfProComputerPrestageEnrollmentsList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the computer prestage enrollments in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		nil,
+	)
+}
+
+// listItems returns all computer prestage enrollments in Jamf Pro as list items. Prestages only reference
+// their site by ID, so the site names are resolved from a single list of all sites.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	sites, err := client.GetSites()
+	if err != nil {
+		return nil, err
+	}
+
+	siteNames := make(map[string]string, len(sites.Site))
+	for _, site := range sites.Site {
+		siteNames[strconv.Itoa(site.ID)] = site.Name
+	}
+
+	response, err := client.GetComputerPrestages("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.Results))
+	for _, item := range response.Results {
+		items = append(items, common.ListItem{
+			ID:   item.ID,
+			Name: item.DisplayName,
+			Site: siteNames[item.SiteId],
+		})
+	}
+
+	return items, nil
+}
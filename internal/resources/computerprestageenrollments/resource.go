@@ -439,7 +439,8 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 			"version_lock": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The version lock.",
+				Computed:    true,
+				Description: "The version lock. Updates always carry the version lock the prestage holds in Jamf Pro at the time.",
 			},
 			"account_settings": {
 				Type:     schema.TypeList,
@@ -27,9 +27,6 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerPrestage)
 		"prevent_activation_lock":               resp.PreventActivationLock,
 		"enable_device_based_activation_lock":   resp.EnableDeviceBasedActivationLock,
 		"device_enrollment_program_instance_id": resp.DeviceEnrollmentProgramInstanceId,
-		"skip_setup_items":                      resp.SkipSetupItems,
-		"location_information":                  resp.LocationInformation,
-		"purchasing_information":                resp.PurchasingInformation,
 		"anchor_certificates":                   resp.AnchorCertificates,
 		"enrollment_customization_id":           resp.EnrollmentCustomizationId,
 		"language":                              resp.Language,
@@ -46,9 +43,10 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerPrestage)
 		"profile_uuid":                          resp.ProfileUuid,
 		"site_id":                               resp.SiteId,
 		"version_lock":                          resp.VersionLock,
-		"account_settings":                      resp.AccountSettings,
 	}
 
+	prestageAttributes["skip_setup_items"] = []interface{}{stateSkipSetupItems(resp.SkipSetupItems)}
+
 	if locationInformation := resp.LocationInformation; locationInformation != (jamfpro.ComputerPrestageSubsetLocationInformation{}) {
 		prestageAttributes["location_information"] = []interface{}{
 			map[string]interface{}{
@@ -130,3 +128,27 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerPrestage)
 
 	return diags
 }
+
+// stateSkipSetupItems flattens the skip setup items into the skip_setup_items block, mirroring constructSkipSetupItems.
+func stateSkipSetupItems(items jamfpro.ComputerPrestageSubsetSkipSetupItems) map[string]interface{} {
+	return map[string]interface{}{
+		"biometric":          items.Biometric,
+		"terms_of_address":   items.TermsOfAddress,
+		"file_vault":         items.FileVault,
+		"icloud_diagnostics": items.ICloudDiagnostics,
+		"diagnostics":        items.Diagnostics,
+		"accessibility":      items.Accessibility,
+		"apple_id":           items.AppleID,
+		"screen_time":        items.ScreenTime,
+		"siri":               items.Siri,
+		"display_tone":       items.DisplayTone,
+		"restore":            items.Restore,
+		"appearance":         items.Appearance,
+		"privacy":            items.Privacy,
+		"payment":            items.Payment,
+		"registration":       items.Registration,
+		"tos":                items.TOS,
+		"icloud_storage":     items.ICloudStorage,
+		"location":           items.Location,
+	}
+}
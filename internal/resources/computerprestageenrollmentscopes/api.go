@@ -0,0 +1,59 @@
+// computerprestageenrollmentscopes_api.go
+package computerprestageenrollmentscopes
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// uriComputerPrestageScopes is the collection below which the scope of computer prestages is served. The scope is
+// only available from version 2 of the API, though the prestages themselves have moved on to version 3.
+const uriComputerPrestageScopes = "/api/v2/computer-prestages"
+
+// scopeChange lists serial numbers to add to or remove from the scope of a computer prestage.
+type scopeChange struct {
+	SerialNumbers []string `json:"serialNumbers"`
+	VersionLock   int      `json:"versionLock"`
+}
+
+// api sends computer prestage scope requests through the HTTP client of a Jamf Pro client. The SDK can read the
+// scope of a computer prestage but not change it.
+type api struct {
+	client *jamfpro.Client
+}
+
+// getScope fetches the scope of a computer prestage.
+func (a api) getScope(id string) (*jamfpro.ResponseDeviceScope, error) {
+	return a.client.GetDeviceScopeForComputerPrestageByID(id)
+}
+
+// addToScope assigns serial numbers to a computer prestage, returning the resulting scope.
+func (a api) addToScope(id string, change *scopeChange) (*jamfpro.ResponseDeviceScope, error) {
+	var scope jamfpro.ResponseDeviceScope
+	if err := a.do("POST", fmt.Sprintf("%s/%s/scope", uriComputerPrestageScopes, id), change, &scope); err != nil {
+		return nil, fmt.Errorf("failed to add devices to the scope of computer prestage by id: %s, error: %v", id, err)
+	}
+
+	return &scope, nil
+}
+
+// removeFromScope unassigns serial numbers from a computer prestage, returning the resulting scope.
+func (a api) removeFromScope(id string, change *scopeChange) (*jamfpro.ResponseDeviceScope, error) {
+	var scope jamfpro.ResponseDeviceScope
+	if err := a.do("POST", fmt.Sprintf("%s/%s/scope/delete-multiple", uriComputerPrestageScopes, id), change, &scope); err != nil {
+		return nil, fmt.Errorf("failed to remove devices from the scope of computer prestage by id: %s, error: %v", id, err)
+	}
+
+	return &scope, nil
+}
+
+// do sends a request and closes the body of its response.
+func (a api) do(method, endpoint string, body, out interface{}) error {
+	resp, err := a.client.HTTP.DoRequest(method, endpoint, body, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,137 @@
+package computerprestageenrollmentscopes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for assigning the configured devices to a Jamf Pro Computer Prestage, removing any other.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	prestageID := d.Get("prestage_id").(string)
+
+	if err := changeScope(ctx, meta, prestageID, d.Timeout(schema.TimeoutCreate), configuredSerialNumbers(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to assign devices to Jamf Pro Computer Prestage (ID: %s): %v", prestageID, err))
+	}
+
+	d.SetId(prestageID)
+
+	return readNoCleanup(ctx, d, meta)
+}
+
+// read is responsible for reading the devices assigned to a Jamf Pro Computer Prestage.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	return common.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		api{meta.(*jamfpro.Client)}.getScope,
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for bringing the devices assigned to a Jamf Pro Computer Prestage in line with the
+// configuration.
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := changeScope(ctx, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), configuredSerialNumbers(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update the devices assigned to Jamf Pro Computer Prestage (ID: %s): %v", d.Id(), err))
+	}
+
+	return readNoCleanup(ctx, d, meta)
+}
+
+// delete is responsible for unassigning the devices of the resource from a Jamf Pro Computer Prestage. Devices
+// assigned since the last refresh are left in place, as are those of a prestage which no longer exists.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	managed := make(map[string]bool)
+	for _, serialNumber := range d.Get("serial_numbers").(*schema.Set).List() {
+		managed[serialNumber.(string)] = true
+	}
+
+	keepUnmanaged := func(inScope []string) []string {
+		var kept []string
+		for _, serialNumber := range inScope {
+			if !managed[serialNumber] {
+				kept = append(kept, serialNumber)
+			}
+		}
+		return kept
+	}
+
+	err := changeScope(ctx, meta, d.Id(), d.Timeout(schema.TimeoutDelete), keepUnmanaged)
+	if err != nil && !common.IsNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("failed to unassign devices from Jamf Pro Computer Prestage (ID: %s): %v", d.Id(), err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// configuredSerialNumbers returns a target scope holding the configured serial numbers only.
+func configuredSerialNumbers(d *schema.ResourceData) func(inScope []string) []string {
+	var configured []string
+	for _, serialNumber := range d.Get("serial_numbers").(*schema.Set).List() {
+		configured = append(configured, serialNumber.(string))
+	}
+
+	return func([]string) []string { return configured }
+}
+
+/*
+changeScope brings the scope of a computer prestage to the serial numbers returned by target for those in scope.
+
+Only the difference is sent, the serial numbers to add in one request and those to remove in another, each carrying
+the version lock of the scope. On a conflict with a concurrent change the scope is read again and the difference
+worked out anew, so a retry never undoes the change of someone else which the target keeps.
+*/
+func changeScope(ctx context.Context, meta interface{}, prestageID string, timeout time.Duration, target func(inScope []string) []string) error {
+	client := api{meta.(*jamfpro.Client)}
+
+	var inScope []string
+	return common.RetryWithVersionLock(ctx, timeout,
+		func() (int, error) {
+			scope, err := client.getScope(prestageID)
+			if err != nil {
+				return 0, err
+			}
+			inScope = serialNumbers(scope)
+			return scope.VersionLock, nil
+		},
+		func(versionLock int) error {
+			add, remove := common.DiffStringSets(inScope, target(inScope))
+
+			if len(add) > 0 {
+				scope, err := client.addToScope(prestageID, &scopeChange{SerialNumbers: add, VersionLock: versionLock})
+				if err != nil {
+					return err
+				}
+				versionLock = scope.VersionLock
+			}
+
+			if len(remove) > 0 {
+				if _, err := client.removeFromScope(prestageID, &scopeChange{SerialNumbers: remove, VersionLock: versionLock}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+}
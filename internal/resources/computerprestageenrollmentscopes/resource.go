@@ -0,0 +1,53 @@
+// computerprestageenrollmentscopes_resource.go
+package computerprestageenrollmentscopes
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProComputerPrestageEnrollmentScope defines the schema for managing the devices assigned to a Jamf Pro
+// Computer Prestage in Terraform. The scope is authoritative: serial numbers assigned to the prestage outside of
+// Terraform are removed on the next apply.
+func ResourceJamfProComputerPrestageEnrollmentScope() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the computer prestage, which identifies its scope.",
+			},
+			"prestage_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the computer prestage the devices are assigned to.",
+			},
+			"serial_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The serial numbers of the computers assigned to the prestage. Changes are sent as the serial numbers to add and to remove, so large scopes are not sent in full on every change.",
+			},
+			"version_lock": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version lock of the scope. Changes always carry the version lock the scope holds in Jamf Pro at the time.",
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+// computerprestageenrollmentscopes_state.go
+package computerprestageenrollmentscopes
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the serial numbers in the scope of the computer prestage.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResponseDeviceScope) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	scopeAttributes := map[string]interface{}{
+		"prestage_id":    d.Id(),
+		"serial_numbers": serialNumbers(resp),
+		"version_lock":   resp.VersionLock,
+	}
+
+	for key, val := range scopeAttributes {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// serialNumbers returns the serial numbers of the devices assigned in a scope.
+func serialNumbers(scope *jamfpro.ResponseDeviceScope) []string {
+	serialNumbers := make([]string, 0, len(scope.Assignments))
+	for _, assignment := range scope.Assignments {
+		serialNumbers = append(serialNumbers, assignment.SerialNumber)
+	}
+	return serialNumbers
+}
@@ -2,15 +2,17 @@
 package diskencryptionconfigurations
 
 import (
+	"context"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProDiskEncryptionConfiguration constructs a ResourceDiskEncryptionConfiguration object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceDiskEncryptionConfiguration, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceDiskEncryptionConfiguration, error) {
 	resource := &jamfpro.ResourceDiskEncryptionConfiguration{
 		Name:                  d.Get("name").(string),
 		KeyType:               d.Get("key_type").(string),
@@ -32,7 +34,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceDiskEncryptionConfigura
 		log.Fatalf("Error: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Disk Encryption Configurations XML:\n%s\n", string(xmlOutput))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Disk Encryption Configurations XML:\n%s", string(xmlOutput))
 
 	return resource, nil
 }
@@ -0,0 +1,51 @@
+// filesharedistributionpoints_api.go
+package filesharedistributionpoints
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+const uriDistributionPoints = "/JSSResource/distributionpoints"
+
+/*
+responseDistributionPointsList is the file share distribution point list of the Classic API.
+
+The SDK models the list with a single distribution point rather than a slice, so only one item survives decoding.
+The list is read through the HTTP client of the SDK with this model instead.
+*/
+type responseDistributionPointsList struct {
+	Size               int                         `xml:"size"`
+	DistributionPoints []distributionPointListItem `xml:"distribution_point"`
+}
+
+type distributionPointListItem struct {
+	ID   int    `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// api sends file share distribution point requests through the HTTP client of a Jamf Pro client.
+type api struct {
+	client *jamfpro.Client
+}
+
+// list fetches every file share distribution point.
+func (a api) list() (*responseDistributionPointsList, error) {
+	var response responseDistributionPointsList
+	if err := a.do("GET", uriDistributionPoints, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get distribution points, error: %v", err)
+	}
+
+	return &response, nil
+}
+
+// do sends a request and closes the body of its response.
+func (a api) do(method, endpoint string, body, out interface{}) error {
+	resp, err := a.client.HTTP.DoRequest(method, endpoint, body, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return err
+}
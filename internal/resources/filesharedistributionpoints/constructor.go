@@ -2,15 +2,17 @@
 package filesharedistributionpoints
 
 import (
+	"context"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // cconstructJamfProFileShareDistributionPoint constructs a ResourceDockItem object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceFileShareDistributionPoint, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceFileShareDistributionPoint, error) {
 	resource := &jamfpro.ResourceFileShareDistributionPoint{
 		Name:                     d.Get("name").(string),
 		IP_Address:               d.Get("ip_address").(string),
@@ -41,7 +43,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceFileShareDistributionPo
 		log.Fatalf("Error: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro File Share Distribution Point XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro File Share Distribution Point XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
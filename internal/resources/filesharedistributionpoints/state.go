@@ -13,10 +13,16 @@ import (
 func updateState(d *schema.ResourceData, resp *jamfpro.ResourceFileShareDistributionPoint) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	// Jamf Pro reports the address under both ipAddress and ip_address, the latter being the one it accepts.
+	ipAddress := resp.IPAddress
+	if ipAddress == "" {
+		ipAddress = resp.IP_Address
+	}
+
 	resourceData := map[string]interface{}{
 		"id":                               strconv.Itoa(resp.ID),
 		"name":                             resp.Name,
-		"ip_address":                       resp.IPAddress,
+		"ip_address":                       ipAddress,
 		"is_master":                        resp.IsMaster,
 		"failover_point":                   resp.FailoverPoint,
 		"failover_point_url":               resp.FailoverPointURL,
@@ -22,7 +22,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistCreate(ctx context.Context, d
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, err := constructJamfProMacOSConfigurationProfilePlist(d)
+	resource, err := constructJamfProMacOSConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile: %v", err))
 	}
@@ -32,7 +32,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistCreate(ctx context.Context, d
 		var apiErr error
 		creationResponse, apiErr = client.CreateMacOSConfigurationProfile(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -61,7 +61,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistRead(ctx context.Context, d *
 		var apiErr error
 		response, apiErr = client.GetMacOSConfigurationProfileByID(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -89,7 +89,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistUpdate(ctx context.Context, d
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, err := constructJamfProMacOSConfigurationProfilePlist(d)
+	resource, err := constructJamfProMacOSConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile for update: %v", err))
 	}
@@ -97,7 +97,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistUpdate(ctx context.Context, d
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := client.UpdateMacOSConfigurationProfileByID(resourceID, resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -122,7 +122,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistDelete(ctx context.Context, d
 		if apiErr != nil {
 			apiErrByName := client.DeleteMacOSConfigurationProfileByName(resourceName)
 			if apiErrByName != nil {
-				return retry.RetryableError(apiErrByName)
+				return common.RetryOnError(ctx, apiErrByName)
 			}
 		}
 		return nil
@@ -73,11 +73,11 @@ func validateMacOSConfigurationProfileLevel(_ context.Context, diff *schema.Reso
 }
 
 // validateConfigurationProfileFormatting validates the indentation of the plist XML.
-func validateConfigurationProfileFormatting(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+func validateConfigurationProfileFormatting(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 	resourceName := diff.Get("name").(string)
 	payloads := diff.Get("payloads").(string)
 
-	if err := datavalidators.CheckPlistIndentationAndWhiteSpace(payloads); err != nil {
+	if err := datavalidators.CheckPlistIndentationAndWhiteSpace(ctx, payloads); err != nil {
 		return fmt.Errorf("in 'jamfpro_macos_configuration_profile.%s': %v", resourceName, err)
 	}
 
@@ -2,11 +2,12 @@
 package macosconfigurationprofilesplist
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 )
 
 // FixDuplicateNotificationKey handles the double key issue in the notification field of the self_service block.
@@ -38,7 +39,7 @@ func FixDuplicateNotificationKey(resp *jamfpro.ResourceMacOSConfigurationProfile
 			}
 			return correctNotifValue, nil
 		} else {
-			log.Printf("Ignoring non-boolean notification value: %s", strValue)
+			logging.Debugf(context.Background(), logging.SubsystemCRUD, "Ignoring non-boolean notification value: %s", strValue)
 		}
 	}
 	// Return default value if no valid boolean value is found
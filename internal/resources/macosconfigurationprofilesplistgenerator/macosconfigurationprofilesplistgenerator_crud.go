@@ -23,7 +23,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorCreate(ctx context.C
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d)
+	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile: %v", err))
 	}
@@ -33,7 +33,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorCreate(ctx context.C
 		var apiErr error
 		creationResponse, apiErr = client.CreateMacOSConfigurationProfile(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -62,7 +62,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorRead(ctx context.Con
 		var apiErr error
 		response, apiErr = client.GetMacOSConfigurationProfileByID(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -90,7 +90,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorUpdate(ctx context.C
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d)
+	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile for update: %v", err))
 	}
@@ -98,7 +98,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorUpdate(ctx context.C
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := client.UpdateMacOSConfigurationProfileByID(resourceID, resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -124,7 +124,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorDelete(ctx context.C
 		if apiErr != nil {
 			apiErrByName := client.DeleteMacOSConfigurationProfileByName(resourceName)
 			if apiErrByName != nil {
-				return retry.RetryableError(apiErrByName)
+				return common.RetryOnError(ctx, apiErrByName)
 			}
 		}
 		return nil
@@ -2,8 +2,9 @@
 package macosconfigurationprofilesplistgenerator
 
 import (
-	"log"
+	"context"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -11,7 +12,7 @@ import (
 func DiffSuppressPayloads(k, old, new string, d *schema.ResourceData) bool {
 	// Suppress diff for specific keys
 	if shouldSuppressKey(k) {
-		log.Printf("Suppressing diff for key: %s", k)
+		logging.Debugf(context.Background(), logging.SubsystemPlist, "Suppressing diff for key: %s", k)
 		return true
 	}
 	return false
@@ -2,18 +2,19 @@
 package mobiledeviceconfigurationprofilesplist
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"html"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProMobileDeviceConfigurationProfile constructs a ResourceMobileDeviceConfigurationProfile object from the provided schema data.
-func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceConfigurationProfile, error) {
+func constructJamfProMobileDeviceConfigurationProfilePlist(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceConfigurationProfile, error) {
 
 	profile := &jamfpro.ResourceMobileDeviceConfigurationProfile{
 		General: jamfpro.MobileDeviceConfigurationProfileSubsetGeneral{
@@ -43,7 +44,7 @@ func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceDat
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Configuration Profile '%s' to XML: %v", profile.General.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Mobile Device Configuration Profile XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Mobile Device Configuration Profile XML:\n%s", string(resourceXML))
 
 	return profile, nil
 }
@@ -22,7 +22,7 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistCreate(ctx context.Cont
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(d)
+	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Mobile Device Configuration Profile: %v", err))
 	}
@@ -32,7 +32,7 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistCreate(ctx context.Cont
 		var apiErr error
 		creationResponse, apiErr = client.CreateMobileDeviceConfigurationProfile(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -61,7 +61,7 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistRead(ctx context.Contex
 		var apiErr error
 		response, apiErr = client.GetMobileDeviceConfigurationProfileByID(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -89,7 +89,7 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistUpdate(ctx context.Cont
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(d)
+	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Mobile Device Configuration Profile for update: %v", err))
 	}
@@ -97,7 +97,7 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistUpdate(ctx context.Cont
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := client.UpdateMobileDeviceConfigurationProfileByID(resourceID, resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -121,7 +121,7 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistDelete(ctx context.Cont
 			resourceName := d.Get("name").(string)
 			apiErrByName := client.DeleteMobileDeviceConfigurationProfileByName(resourceName)
 			if apiErrByName != nil {
-				return retry.RetryableError(apiErrByName)
+				return common.RetryOnError(ctx, apiErrByName)
 			}
 		}
 		return nil
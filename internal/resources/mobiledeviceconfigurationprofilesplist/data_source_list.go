@@ -0,0 +1,83 @@
+// mobiledeviceconfigurationprofilesplist_data_source_list.go
+package mobiledeviceconfigurationprofilesplist
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the mobile device configuration profiles list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "mobile device configuration profile",
+	Category:         true,
+	Site:             true,
+	FilterOnly:       true,
+}
+
+// DataSourceJamfProMobileDeviceConfigurationProfilesPlistList provides the mobile device configuration profiles in Jamf Pro, optionally filtered by name, category or site.
+func DataSourceJamfProMobileDeviceConfigurationProfilesPlistList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the mobile device configuration profiles in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		func(item *common.ListItem) error {
+			return describeItem(client, item)
+		},
+	)
+}
+
+// listItems returns all mobile device configuration profiles in Jamf Pro as list items.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetMobileDeviceConfigurationProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.ConfigurationProfiles))
+	for _, item := range response.ConfigurationProfiles {
+		items = append(items, common.ListItem{
+			ID:   strconv.Itoa(item.ID),
+			Name: item.Name,
+		})
+	}
+
+	return items, nil
+}
+
+// describeItem completes a mobile device configuration profile list item with its category and site, which the list endpoint omits.
+func describeItem(client *jamfpro.Client, item *common.ListItem) error {
+	resource, err := client.GetMobileDeviceConfigurationProfileByID(item.ID)
+	if err != nil {
+		return err
+	}
+
+	if resource.General.Category != nil {
+		item.Category = resource.General.Category.Name
+	}
+	if resource.General.Site != nil {
+		item.Site = resource.General.Site.Name
+	}
+
+	return nil
+}
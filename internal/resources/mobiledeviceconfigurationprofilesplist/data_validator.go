@@ -57,11 +57,11 @@ func validateMobileDeviceConfigurationProfileLevel(_ context.Context, diff *sche
 }
 
 // validateConfigurationProfileFormatting validates the indentation of the plist XML.
-func validateConfigurationProfileFormatting(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+func validateConfigurationProfileFormatting(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 	resourceName := diff.Get("name").(string)
 	payloads := diff.Get("payloads").(string)
 
-	if err := datavalidators.CheckPlistIndentationAndWhiteSpace(payloads); err != nil {
+	if err := datavalidators.CheckPlistIndentationAndWhiteSpace(ctx, payloads); err != nil {
 		return fmt.Errorf("in 'jamfpro_mobile_device_configuration_profile.%s': %v", resourceName, err)
 	}
 
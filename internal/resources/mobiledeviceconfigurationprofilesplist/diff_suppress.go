@@ -2,8 +2,9 @@
 package mobiledeviceconfigurationprofilesplist
 
 import (
-	"log"
+	"context"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/configurationprofiles/plist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,36 +12,36 @@ import (
 
 // DiffSuppressPayloads is a custom diff suppression function for the payloads attribute.
 func DiffSuppressPayloads(k, old, new string, d *schema.ResourceData) bool {
-	log.Printf("Suppressing diff for key: %s", k)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Suppressing diff for key: %s", k)
 
 	processedOldPayload, err := processPayload(old)
 	if err != nil {
-		log.Printf("Error processing old payload: %v", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error processing old payload: %v", err)
 		return false
 	}
 
 	processedNewPayload, err := processPayload(new)
 	if err != nil {
-		log.Printf("Error processing new payload: %v", err)
+		logging.Warnf(context.Background(), logging.SubsystemPlist, "Error processing new payload: %v", err)
 		return false
 	}
 
 	oldHash := common.HashString(processedOldPayload)
 	newHash := common.HashString(processedNewPayload)
 
-	log.Printf("Old payload hash: %s, New payload hash: %s", oldHash, newHash)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Old payload hash: %s, New payload hash: %s", oldHash, newHash)
 
 	return oldHash == newHash
 }
 
 // processPayload processes the payload by comparing the old and new payloads. It removes specified fields and compares the hashes.
 func processPayload(payload string) (string, error) {
-	log.Printf("Processing payload: %s", payload)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Processing payload: %s", payload)
 	fieldsToRemove := []string{"PayloadUUID", "PayloadIdentifier", "PayloadOrganization", "PayloadDisplayName"}
 	processedPayload, err := plist.ProcessConfigurationProfileForDiffSuppression(payload, fieldsToRemove)
 	if err != nil {
 		return "", err
 	}
-	log.Printf("Processed payload: %s", processedPayload)
+	logging.Debugf(context.Background(), logging.SubsystemPlist, "Processed payload: %s", processedPayload)
 	return processedPayload, nil
 }
@@ -0,0 +1,89 @@
+// mobiledeviceextensionattributes_api.go
+package mobiledeviceextensionattributes
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+const uriMobileDeviceExtensionAttributes = "/JSSResource/mobiledeviceextensionattributes"
+
+/*
+resourceMobileDeviceExtensionAttribute is a mobile device extension attribute of the Classic API.
+
+The SDK models the input type of mobile device extension attributes without its pop-up choices or directory service
+mapping, so the attribute is sent and read through the HTTP client of the SDK with this complete model instead.
+*/
+type resourceMobileDeviceExtensionAttribute struct {
+	XMLName          xml.Name                                      `xml:"mobile_device_extension_attribute"`
+	ID               int                                           `xml:"id,omitempty"`
+	Name             string                                        `xml:"name"`
+	Description      string                                        `xml:"description"`
+	DataType         string                                        `xml:"data_type"`
+	InputType        mobileDeviceExtensionAttributeSubsetInputType `xml:"input_type"`
+	InventoryDisplay string                                        `xml:"inventory_display,omitempty"`
+}
+
+type mobileDeviceExtensionAttributeSubsetInputType struct {
+	Type             string   `xml:"type"`
+	Choices          []string `xml:"popup_choices>choice,omitempty"`
+	AttributeMapping string   `xml:"attribute_mapping,omitempty"`
+}
+
+// api sends mobile device extension attribute requests through the HTTP client of a Jamf Pro client.
+type api struct {
+	client *jamfpro.Client
+}
+
+// getByID fetches a mobile device extension attribute by its ID.
+func (a api) getByID(id string) (*resourceMobileDeviceExtensionAttribute, error) {
+	var attribute resourceMobileDeviceExtensionAttribute
+	if err := a.do("GET", fmt.Sprintf("%s/id/%s", uriMobileDeviceExtensionAttributes, id), nil, &attribute); err != nil {
+		return nil, fmt.Errorf("failed to get mobile device extension attribute by id: %s, error: %v", id, err)
+	}
+
+	return &attribute, nil
+}
+
+// getByName fetches a mobile device extension attribute by its name.
+func (a api) getByName(name string) (*resourceMobileDeviceExtensionAttribute, error) {
+	var attribute resourceMobileDeviceExtensionAttribute
+	if err := a.do("GET", fmt.Sprintf("%s/name/%s", uriMobileDeviceExtensionAttributes, url.PathEscape(name)), nil, &attribute); err != nil {
+		return nil, fmt.Errorf("failed to get mobile device extension attribute by name: %s, error: %v", name, err)
+	}
+
+	return &attribute, nil
+}
+
+// create creates a mobile device extension attribute, returning its ID.
+func (a api) create(attribute *resourceMobileDeviceExtensionAttribute) (*resourceMobileDeviceExtensionAttribute, error) {
+	var response resourceMobileDeviceExtensionAttribute
+	if err := a.do("POST", uriMobileDeviceExtensionAttributes+"/id/0", attribute, &response); err != nil {
+		return nil, fmt.Errorf("failed to create mobile device extension attribute, error: %v", err)
+	}
+
+	return &response, nil
+}
+
+// updateByID updates a mobile device extension attribute by its ID.
+func (a api) updateByID(id string, attribute *resourceMobileDeviceExtensionAttribute) (*resourceMobileDeviceExtensionAttribute, error) {
+	var response resourceMobileDeviceExtensionAttribute
+	if err := a.do("PUT", fmt.Sprintf("%s/id/%s", uriMobileDeviceExtensionAttributes, id), attribute, &response); err != nil {
+		return nil, fmt.Errorf("failed to update mobile device extension attribute by id: %s, error: %v", id, err)
+	}
+
+	return &response, nil
+}
+
+// do sends a request and closes the body of its response.
+func (a api) do(method, endpoint string, body, out interface{}) error {
+	resp, err := a.client.HTTP.DoRequest(method, endpoint, body, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return err
+}
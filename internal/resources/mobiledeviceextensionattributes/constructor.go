@@ -0,0 +1,49 @@
+// mobiledeviceextensionattributes_object.go
+package mobiledeviceextensionattributes
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/extensionattributes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a mobile device extension attribute object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*resourceMobileDeviceExtensionAttribute, error) {
+	resource := &resourceMobileDeviceExtensionAttribute{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		DataType:         d.Get("data_type").(string),
+		InventoryDisplay: d.Get("inventory_display").(string),
+	}
+
+	inputs := extensionattributes.Inputs{
+		Type:             d.Get("input_type").(string),
+		PopupChoices:     extensionattributes.PopupChoices(d.Get("input_popup").([]interface{})),
+		DirectoryMapping: d.Get("input_directory_mapping").(string),
+	}
+	if err := extensionattributes.ValidateInputs(inputs); err != nil {
+		return nil, err
+	}
+
+	resource.InputType.Type = inputs.Type
+
+	if strings.EqualFold(inputs.Type, extensionattributes.InputTypePopupMenu) {
+		resource.InputType.Choices = inputs.PopupChoices
+	} else if strings.EqualFold(inputs.Type, extensionattributes.InputTypeDirectoryMapping) {
+		resource.InputType.AttributeMapping = inputs.DirectoryMapping
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Extension Attribute '%s' to XML: %v", resource.Name, err)
+	}
+
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Mobile Device Extension Attribute XML:\n%s", string(resourceXML))
+
+	return resource, nil
+}
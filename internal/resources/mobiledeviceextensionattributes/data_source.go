@@ -0,0 +1,80 @@
+// mobiledeviceextensionattributes_data_source.go
+package mobiledeviceextensionattributes
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDeviceExtensionAttributes provides information about a specific mobile device extension attribute by its ID or Name.
+func DataSourceJamfProMobileDeviceExtensionAttributes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the mobile device extension attribute. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the mobile device extension attribute. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+		},
+	}
+}
+
+// dataSourceRead fetches the details of a specific mobile device extension attribute from Jamf Pro using either its unique name or its ID.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Mobile Device Extension Attribute",
+		"name",
+		api{client}.getByID,
+		api{client}.getByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
+
+// listNames returns the names of all mobile device extension attributes in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetMobileExtensionAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(response.MobileDeviceExtensionAttribute))
+	for _, item := range response.MobileDeviceExtensionAttribute {
+		names = append(names, item.Name)
+	}
+
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the mobile device extension attribute returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *resourceMobileDeviceExtensionAttribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(strconv.Itoa(resource.ID))
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,84 @@
+// mobiledeviceextensionattributes_resource.go
+package mobiledeviceextensionattributes
+
+import (
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/extensionattributes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProMobileDeviceExtensionAttributes defines the schema and CRUD operations (Create, Read, Update, Delete)
+// for managing Jamf Pro Mobile Device Extension Attributes in Terraform.
+func ResourceJamfProMobileDeviceExtensionAttributes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the mobile device extension attribute.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique name of the Jamf Pro mobile device extension attribute.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the mobile device extension attribute.",
+			},
+			"data_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "string",
+				Description: "Data type of the mobile device extension attribute. Can be string / integer / date (YYYY-MM-DD hh:mm:ss). Value defaults to `String`.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				ValidateFunc: validation.StringInSlice([]string{"string", "integer", "date"}, false),
+			},
+			"input_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Extension Attribute Input Type. Mobile device extension attributes are populated through a text field, a pop-up menu or a directory service (LDAP) attribute mapping.",
+				ValidateFunc: validation.StringInSlice([]string{extensionattributes.InputTypeTextField, extensionattributes.InputTypePopupMenu, extensionattributes.InputTypeDirectoryMapping}, true),
+			},
+			"input_popup": {
+				Type:        schema.TypeList,
+				Description: "List of popup choices. Required when `input_type` is `Pop-up Menu`.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"input_directory_mapping": {
+				Type:        schema.TypeString,
+				Description: "Directory service attribute the extension attribute is mapped from when `input_type` is `LDAP Attribute Mapping`.",
+				Optional:    true,
+			},
+			"inventory_display": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "General",
+				Description:  "Display details for inventory for the mobile device extension attribute. Value defaults to `General`.",
+				ValidateFunc: validation.StringInSlice([]string{"General", "Hardware", "User and Location", "Purchasing", "Extension Attributes"}, false),
+			},
+		},
+	}
+}
@@ -0,0 +1,24 @@
+// mobiledeviceextensionattributes_state.go
+package mobiledeviceextensionattributes
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Mobile Device Extension Attribute information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *resourceMobileDeviceExtensionAttribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("name", resp.Name)
+	d.Set("description", resp.Description)
+	d.Set("data_type", strings.ToLower(resp.DataType))
+	d.Set("inventory_display", resp.InventoryDisplay)
+	d.Set("input_type", resp.InputType.Type)
+	d.Set("input_popup", resp.InputType.Choices)
+	d.Set("input_directory_mapping", resp.InputType.AttributeMapping)
+
+	return diags
+}
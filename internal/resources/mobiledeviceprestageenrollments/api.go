@@ -0,0 +1,213 @@
+// mobiledeviceprestageenrollments_api.go
+package mobiledeviceprestageenrollments
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+)
+
+const uriMobileDevicePrestages = "/api/v2/mobile-device-prestages"
+
+/*
+resourceMobileDevicePrestage is a mobile device prestage enrollment of the Jamf Pro API.
+
+The SDK can create, read and delete mobile device prestages but not update them or their scope, and it reads
+purchasing information with the model of location information, so prestages are sent and read through the HTTP
+client of the SDK with this model instead.
+*/
+type resourceMobileDevicePrestage struct {
+	ID                                  string                               `json:"id,omitempty"`
+	DisplayName                         string                               `json:"displayName"`
+	Mandatory                           bool                                 `json:"mandatory"`
+	MdmRemovable                        bool                                 `json:"mdmRemovable"`
+	SupportPhoneNumber                  string                               `json:"supportPhoneNumber"`
+	SupportEmailAddress                 string                               `json:"supportEmailAddress"`
+	Department                          string                               `json:"department"`
+	DefaultPrestage                     bool                                 `json:"defaultPrestage"`
+	EnrollmentSiteID                    string                               `json:"enrollmentSiteId"`
+	KeepExistingSiteMembership          bool                                 `json:"keepExistingSiteMembership"`
+	KeepExistingLocationInformation     bool                                 `json:"keepExistingLocationInformation"`
+	RequireAuthentication               bool                                 `json:"requireAuthentication"`
+	AuthenticationPrompt                string                               `json:"authenticationPrompt"`
+	PreventActivationLock               bool                                 `json:"preventActivationLock"`
+	EnableDeviceBasedActivationLock     bool                                 `json:"enableDeviceBasedActivationLock"`
+	DeviceEnrollmentProgramInstanceID   string                               `json:"deviceEnrollmentProgramInstanceId"`
+	SkipSetupItems                      map[string]bool                      `json:"skipSetupItems"`
+	LocationInformation                 mobileDevicePrestageSubsetLocation   `json:"locationInformation"`
+	PurchasingInformation               mobileDevicePrestageSubsetPurchasing `json:"purchasingInformation"`
+	AnchorCertificates                  []string                             `json:"anchorCertificates"`
+	EnrollmentCustomizationID           string                               `json:"enrollmentCustomizationId"`
+	Language                            string                               `json:"language"`
+	Region                              string                               `json:"region"`
+	AutoAdvanceSetup                    bool                                 `json:"autoAdvanceSetup"`
+	AllowPairing                        bool                                 `json:"allowPairing"`
+	MultiUser                           bool                                 `json:"multiUser"`
+	Supervised                          bool                                 `json:"supervised"`
+	MaximumSharedAccounts               int                                  `json:"maximumSharedAccounts"`
+	ConfigureDeviceBeforeSetupAssistant bool                                 `json:"configureDeviceBeforeSetupAssistant"`
+	Names                               mobileDevicePrestageSubsetNames      `json:"names"`
+	SendTimezone                        bool                                 `json:"sendTimezone"`
+	Timezone                            string                               `json:"timezone"`
+	StorageQuotaSizeMegabytes           int                                  `json:"storageQuotaSizeMegabytes"`
+	UseStorageQuotaSize                 bool                                 `json:"useStorageQuotaSize"`
+	TemporarySessionOnly                bool                                 `json:"temporarySessionOnly"`
+	EnforceTemporarySessionTimeout      bool                                 `json:"enforceTemporarySessionTimeout"`
+	TemporarySessionTimeout             int                                  `json:"temporarySessionTimeout"`
+	EnforceUserSessionTimeout           bool                                 `json:"enforceUserSessionTimeout"`
+	UserSessionTimeout                  int                                  `json:"userSessionTimeout"`
+	ProfileUUID                         string                               `json:"profileUuid,omitempty"`
+	SiteID                              string                               `json:"siteId"`
+	VersionLock                         int                                  `json:"versionLock"`
+}
+
+type mobileDevicePrestageSubsetLocation struct {
+	ID           string `json:"id,omitempty"`
+	Username     string `json:"username"`
+	Realname     string `json:"realname"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`
+	Room         string `json:"room"`
+	Position     string `json:"position"`
+	DepartmentID string `json:"departmentId"`
+	BuildingID   string `json:"buildingId"`
+	VersionLock  int    `json:"versionLock"`
+}
+
+type mobileDevicePrestageSubsetPurchasing struct {
+	ID                string `json:"id,omitempty"`
+	Leased            bool   `json:"leased"`
+	Purchased         bool   `json:"purchased"`
+	AppleCareID       string `json:"appleCareId"`
+	PONumber          string `json:"poNumber"`
+	Vendor            string `json:"vendor"`
+	PurchasePrice     string `json:"purchasePrice"`
+	LifeExpectancy    int    `json:"lifeExpectancy"`
+	PurchasingAccount string `json:"purchasingAccount"`
+	PurchasingContact string `json:"purchasingContact"`
+	LeaseDate         string `json:"leaseDate"`
+	PODate            string `json:"poDate"`
+	WarrantyDate      string `json:"warrantyDate"`
+	VersionLock       int    `json:"versionLock"`
+}
+
+type mobileDevicePrestageSubsetNames struct {
+	AssignNamesUsing       string                                `json:"assignNamesUsing"`
+	PrestageDeviceNames    []mobileDevicePrestageSubsetNamesName `json:"prestageDeviceNames"`
+	DeviceNamePrefix       string                                `json:"deviceNamePrefix"`
+	DeviceNameSuffix       string                                `json:"deviceNameSuffix"`
+	SingleDeviceName       string                                `json:"singleDeviceName"`
+	ManageNames            bool                                  `json:"manageNames"`
+	DeviceNamingConfigured bool                                  `json:"deviceNamingConfigured"`
+}
+
+type mobileDevicePrestageSubsetNamesName struct {
+	ID         string `json:"id,omitempty"`
+	DeviceName string `json:"deviceName"`
+	Used       bool   `json:"used"`
+}
+
+// mobileDevicePrestageScope is the scope of a prestage, the devices assigned to it by serial number.
+type mobileDevicePrestageScope struct {
+	PrestageID  string                                `json:"prestageId,omitempty"`
+	Assignments []mobileDevicePrestageScopeAssignment `json:"assignments,omitempty"`
+	VersionLock int                                   `json:"versionLock"`
+}
+
+type mobileDevicePrestageScopeAssignment struct {
+	SerialNumber   string `json:"serialNumber"`
+	AssignmentDate string `json:"assignmentDate"`
+	UserAssigned   string `json:"userAssigned"`
+}
+
+// mobileDevicePrestageScopeReplacement replaces the serial numbers of the scope of a prestage.
+type mobileDevicePrestageScopeReplacement struct {
+	SerialNumbers []string `json:"serialNumbers"`
+	VersionLock   int      `json:"versionLock"`
+}
+
+// api sends mobile device prestage requests through the HTTP client of a Jamf Pro client.
+type api struct {
+	client *jamfpro.Client
+}
+
+// getByID fetches a mobile device prestage by its ID.
+func (a api) getByID(id string) (*resourceMobileDevicePrestage, error) {
+	var prestage resourceMobileDevicePrestage
+	if err := a.do("GET", fmt.Sprintf("%s/%s", uriMobileDevicePrestages, id), nil, &prestage); err != nil {
+		return nil, fmt.Errorf("failed to get mobile device prestage by id: %s, error: %v", id, err)
+	}
+
+	return &prestage, nil
+}
+
+// getByName fetches a mobile device prestage by its display name.
+func (a api) getByName(name string) (*resourceMobileDevicePrestage, error) {
+	prestages, err := a.client.GetMobileDevicePrestages("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mobile device prestage by name: %s, error: %v", name, err)
+	}
+
+	for _, prestage := range prestages.Results {
+		if prestage.DisplayName == name {
+			return a.getByID(prestage.ID)
+		}
+	}
+
+	return nil, &common.APIError{
+		StatusCode: http.StatusNotFound,
+		Err:        fmt.Errorf("failed to get mobile device prestage by name: %s, error: no prestage with this display name exists", name),
+	}
+}
+
+// create creates a mobile device prestage, returning its ID.
+func (a api) create(prestage *resourceMobileDevicePrestage) (*jamfpro.ResponseMobileDevicePrestageCreate, error) {
+	var response jamfpro.ResponseMobileDevicePrestageCreate
+	if err := a.do("POST", uriMobileDevicePrestages, prestage, &response); err != nil {
+		return nil, fmt.Errorf("failed to create mobile device prestage, error: %v", err)
+	}
+
+	return &response, nil
+}
+
+// updateByID replaces a mobile device prestage by its ID.
+func (a api) updateByID(id string, prestage *resourceMobileDevicePrestage) (*resourceMobileDevicePrestage, error) {
+	var response resourceMobileDevicePrestage
+	if err := a.do("PUT", fmt.Sprintf("%s/%s", uriMobileDevicePrestages, id), prestage, &response); err != nil {
+		return nil, fmt.Errorf("failed to update mobile device prestage by id: %s, error: %v", id, err)
+	}
+
+	return &response, nil
+}
+
+// getScope fetches the scope of a mobile device prestage.
+func (a api) getScope(id string) (*mobileDevicePrestageScope, error) {
+	var scope mobileDevicePrestageScope
+	if err := a.do("GET", fmt.Sprintf("%s/%s/scope", uriMobileDevicePrestages, id), nil, &scope); err != nil {
+		return nil, fmt.Errorf("failed to get the scope of mobile device prestage by id: %s, error: %v", id, err)
+	}
+
+	return &scope, nil
+}
+
+// replaceScope replaces the serial numbers of the scope of a mobile device prestage.
+func (a api) replaceScope(id string, replacement *mobileDevicePrestageScopeReplacement) error {
+	var scope mobileDevicePrestageScope
+	if err := a.do("PUT", fmt.Sprintf("%s/%s/scope", uriMobileDevicePrestages, id), replacement, &scope); err != nil {
+		return fmt.Errorf("failed to update the scope of mobile device prestage by id: %s, error: %v", id, err)
+	}
+
+	return nil
+}
+
+// do sends a request and closes the body of its response.
+func (a api) do(method, endpoint string, body, out interface{}) error {
+	resp, err := a.client.HTTP.DoRequest(method, endpoint, body, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return err
+}
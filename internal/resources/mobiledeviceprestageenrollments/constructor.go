@@ -0,0 +1,147 @@
+// mobiledeviceprestageenrollments_object.go
+package mobiledeviceprestageenrollments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a mobile device prestage object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*resourceMobileDevicePrestage, error) {
+	resource := &resourceMobileDevicePrestage{
+		DisplayName:                         d.Get("display_name").(string),
+		Mandatory:                           d.Get("mandatory").(bool),
+		MdmRemovable:                        d.Get("mdm_removable").(bool),
+		SupportPhoneNumber:                  d.Get("support_phone_number").(string),
+		SupportEmailAddress:                 d.Get("support_email_address").(string),
+		Department:                          d.Get("department").(string),
+		DefaultPrestage:                     d.Get("default_prestage").(bool),
+		EnrollmentSiteID:                    d.Get("enrollment_site_id").(string),
+		KeepExistingSiteMembership:          d.Get("keep_existing_site_membership").(bool),
+		KeepExistingLocationInformation:     d.Get("keep_existing_location_information").(bool),
+		RequireAuthentication:               d.Get("require_authentication").(bool),
+		AuthenticationPrompt:                d.Get("authentication_prompt").(string),
+		PreventActivationLock:               d.Get("prevent_activation_lock").(bool),
+		EnableDeviceBasedActivationLock:     d.Get("enable_device_based_activation_lock").(bool),
+		DeviceEnrollmentProgramInstanceID:   d.Get("device_enrollment_program_instance_id").(string),
+		SkipSetupItems:                      make(map[string]bool, len(skipSetupItems)),
+		AnchorCertificates:                  expandStrings(d.Get("anchor_certificates").([]interface{})),
+		EnrollmentCustomizationID:           d.Get("enrollment_customization_id").(string),
+		Language:                            d.Get("language").(string),
+		Region:                              d.Get("region").(string),
+		AutoAdvanceSetup:                    d.Get("auto_advance_setup").(bool),
+		AllowPairing:                        d.Get("allow_pairing").(bool),
+		MultiUser:                           d.Get("multi_user").(bool),
+		Supervised:                          d.Get("supervised").(bool),
+		MaximumSharedAccounts:               d.Get("maximum_shared_accounts").(int),
+		ConfigureDeviceBeforeSetupAssistant: d.Get("configure_device_before_setup_assistant").(bool),
+		SendTimezone:                        d.Get("send_timezone").(bool),
+		Timezone:                            d.Get("timezone").(string),
+		StorageQuotaSizeMegabytes:           d.Get("storage_quota_size_megabytes").(int),
+		UseStorageQuotaSize:                 d.Get("use_storage_quota_size").(bool),
+		TemporarySessionOnly:                d.Get("temporary_session_only").(bool),
+		EnforceTemporarySessionTimeout:      d.Get("enforce_temporary_session_timeout").(bool),
+		TemporarySessionTimeout:             d.Get("temporary_session_timeout").(int),
+		EnforceUserSessionTimeout:           d.Get("enforce_user_session_timeout").(bool),
+		UserSessionTimeout:                  d.Get("user_session_timeout").(int),
+		SiteID:                              d.Get("site_id").(string),
+		VersionLock:                         d.Get("version_lock").(int),
+		LocationInformation:                 mobileDevicePrestageSubsetLocation{DepartmentID: "-1", BuildingID: "-1"},
+		Names:                               mobileDevicePrestageSubsetNames{AssignNamesUsing: "Default Names"},
+	}
+
+	skipped, _ := firstBlock(d, "skip_setup_items")
+	for _, item := range skipSetupItems {
+		skip, _ := skipped[item.attribute].(bool)
+		resource.SkipSetupItems[item.key] = skip
+	}
+
+	if data, ok := firstBlock(d, "location_information"); ok {
+		resource.LocationInformation = constructLocationInformation(data)
+	}
+
+	if data, ok := firstBlock(d, "purchasing_information"); ok {
+		resource.PurchasingInformation = constructPurchasingInformation(data)
+	}
+
+	if data, ok := firstBlock(d, "names"); ok {
+		resource.Names = constructNames(data)
+	}
+
+	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Prestage Enrollment '%s' to JSON: %v", resource.DisplayName, err)
+	}
+
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Mobile Device Prestage Enrollment JSON:\n%s", string(resourceJSON))
+
+	return resource, nil
+}
+
+// firstBlock returns the attributes of a block limited to a single item, if it is set.
+func firstBlock(d *schema.ResourceData, key string) (map[string]interface{}, bool) {
+	blocks, ok := d.Get(key).([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil, false
+	}
+	return blocks[0].(map[string]interface{}), true
+}
+
+// expandStrings converts a list of the schema to strings.
+func expandStrings(list []interface{}) []string {
+	values := make([]string, 0, len(list))
+	for _, value := range list {
+		values = append(values, value.(string))
+	}
+	return values
+}
+
+// Helper functions for complex structures
+func constructLocationInformation(data map[string]interface{}) mobileDevicePrestageSubsetLocation {
+	return mobileDevicePrestageSubsetLocation{
+		Username:     data["username"].(string),
+		Realname:     data["realname"].(string),
+		Phone:        data["phone"].(string),
+		Email:        data["email"].(string),
+		Room:         data["room"].(string),
+		Position:     data["position"].(string),
+		DepartmentID: data["department_id"].(string),
+		BuildingID:   data["building_id"].(string),
+	}
+}
+
+func constructPurchasingInformation(data map[string]interface{}) mobileDevicePrestageSubsetPurchasing {
+	return mobileDevicePrestageSubsetPurchasing{
+		Leased:            data["leased"].(bool),
+		Purchased:         data["purchased"].(bool),
+		AppleCareID:       data["apple_care_id"].(string),
+		PONumber:          data["po_number"].(string),
+		Vendor:            data["vendor"].(string),
+		PurchasePrice:     data["purchase_price"].(string),
+		LifeExpectancy:    data["life_expectancy"].(int),
+		PurchasingAccount: data["purchasing_account"].(string),
+		PurchasingContact: data["purchasing_contact"].(string),
+		LeaseDate:         data["lease_date"].(string),
+		PODate:            data["po_date"].(string),
+		WarrantyDate:      data["warranty_date"].(string),
+	}
+}
+
+func constructNames(data map[string]interface{}) mobileDevicePrestageSubsetNames {
+	names := mobileDevicePrestageSubsetNames{
+		AssignNamesUsing:       data["assign_names_using"].(string),
+		DeviceNamePrefix:       data["device_name_prefix"].(string),
+		DeviceNameSuffix:       data["device_name_suffix"].(string),
+		SingleDeviceName:       data["single_device_name"].(string),
+		ManageNames:            data["manage_names"].(bool),
+		DeviceNamingConfigured: true,
+	}
+	for _, name := range expandStrings(data["device_names"].([]interface{})) {
+		names.PrestageDeviceNames = append(names.PrestageDeviceNames, mobileDevicePrestageSubsetNamesName{DeviceName: name})
+	}
+	return names
+}
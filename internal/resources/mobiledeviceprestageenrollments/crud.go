@@ -0,0 +1,125 @@
+package mobiledeviceprestageenrollments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating a new Jamf Pro Mobile Device Prestage and assigning its scope.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return common.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		api{meta.(*jamfpro.Client)}.create,
+		assignScopeAndRead,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Mobile Device Prestage and its scope.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	client := api{meta.(*jamfpro.Client)}
+
+	diags := common.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		client.getByID,
+		updateState,
+	)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	scope, err := client.getScope(d.Id())
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, updateScopeState(d, scope)...)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Mobile Device Prestage and its scope, each against the
+// version lock it holds at the time.
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := api{meta.(*jamfpro.Client)}
+
+	return common.UpdateWithVersionLock(
+		ctx,
+		d,
+		meta,
+		construct,
+		client.getByID,
+		copyVersionLocks,
+		client.updateByID,
+		assignScopeAndRead,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Mobile Device Prestage.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return common.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteMobileDevicePrestageByID,
+	)
+}
+
+// copyVersionLocks copies the version locks of the stored prestage and of its nested settings onto an update, along
+// with the IDs Jamf Pro expects the nested settings to keep.
+func copyVersionLocks(payload, current *resourceMobileDevicePrestage) {
+	payload.VersionLock = current.VersionLock
+	payload.LocationInformation.ID = current.LocationInformation.ID
+	payload.LocationInformation.VersionLock = current.LocationInformation.VersionLock
+	payload.PurchasingInformation.ID = current.PurchasingInformation.ID
+	payload.PurchasingInformation.VersionLock = current.PurchasingInformation.VersionLock
+}
+
+// assignScopeAndRead replaces the serial numbers in the scope of the prestage if they changed, then reads it.
+func assignScopeAndRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("assigned_device_serial_numbers") {
+		client := api{meta.(*jamfpro.Client)}
+		serialNumbers := expandStrings(d.Get("assigned_device_serial_numbers").(*schema.Set).List())
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
+		err := common.RetryWithVersionLock(ctx, timeout,
+			func() (int, error) {
+				scope, err := client.getScope(d.Id())
+				if err != nil {
+					return 0, err
+				}
+				return scope.VersionLock, nil
+			},
+			func(versionLock int) error {
+				return client.replaceScope(d.Id(), &mobileDevicePrestageScopeReplacement{SerialNumbers: serialNumbers, VersionLock: versionLock})
+			},
+		)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to assign devices to Jamf Pro Mobile Device Prestage (ID: %s): %v", d.Id(), err))
+		}
+	}
+
+	return readNoCleanup(ctx, d, meta)
+}
@@ -0,0 +1,83 @@
+// mobiledeviceprestageenrollments_data_source.go
+package mobiledeviceprestageenrollments
+
+import (
+	"context"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDevicePrestageEnrollment provides information about a specific mobile device prestage enrollment in Jamf Pro.
+func DataSourceJamfProMobileDevicePrestageEnrollment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the mobile device prestage enrollment. Conflicts with `display_name`.",
+				ExactlyOneOf: []string{"id", "display_name"},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique display name of the mobile device prestage enrollment. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "display_name"},
+			},
+		},
+	}
+}
+
+// dataSourceRead fetches the details of a specific mobile device prestage enrollment from Jamf Pro using either its unique display name or its ID.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Mobile Device Prestage Enrollment",
+		"display_name",
+		api{client}.getByID,
+		api{client}.getByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
+
+// listNames returns the display names of all mobile device prestage enrollments in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetMobileDevicePrestages("")
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(response.Results))
+	for _, item := range response.Results {
+		names = append(names, item.DisplayName)
+	}
+
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the mobile device prestage enrollment returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *resourceMobileDevicePrestage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(resource.ID)
+	if err := d.Set("display_name", resource.DisplayName); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
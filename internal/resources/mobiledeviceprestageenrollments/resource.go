@@ -0,0 +1,488 @@
+// mobiledeviceprestageenrollments_resource.go
+package mobiledeviceprestageenrollments
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// skipSetupItems maps the attributes of the skip_setup_items block to the Setup Assistant panes Jamf Pro names them by.
+var skipSetupItems = []struct {
+	attribute string
+	key       string
+	pane      string
+}{
+	{"location", "Location", "Location Services"},
+	{"privacy", "Privacy", "Data & Privacy"},
+	{"biometric", "Biometric", "Touch ID / Face ID"},
+	{"software_update", "SoftwareUpdate", "Software Update"},
+	{"diagnostics", "Diagnostics", "App Analytics"},
+	{"imessage_and_facetime", "iMessageAndFaceTime", "iMessage & FaceTime"},
+	{"intelligence", "Intelligence", "Apple Intelligence"},
+	{"passcode", "Passcode", "Passcode"},
+	{"sim_setup", "SIMSetup", "Cellular Setup"},
+	{"screen_time", "ScreenTime", "Screen Time"},
+	{"restore_completed", "RestoreCompleted", "Restore Completed"},
+	{"siri", "Siri", "Siri"},
+	{"restore", "Restore", "Apps & Data"},
+	{"home_button_sensitivity", "HomeButtonSensitivity", "Home Button"},
+	{"cloud_storage", "CloudStorage", "iCloud Storage"},
+	{"transfer_data", "TransferData", "Quick Start"},
+	{"enable_lockdown_mode", "EnableLockdownMode", "Lockdown Mode"},
+	{"zoom", "Zoom", "Display Zoom"},
+	{"preferred_language", "PreferredLanguage", "Preferred Language Order"},
+	{"voice_selection", "VoiceSelection", "Siri Voice"},
+	{"safety", "Safety", "Emergency SOS"},
+	{"terms_of_address", "TermsOfAddress", "Terms of Address"},
+	{"express_language", "ExpressLanguage", "Express Language"},
+	{"camera_button", "CameraButton", "Camera Control"},
+	{"apple_id", "AppleID", "Apple Account"},
+	{"display_tone", "DisplayTone", "True Tone"},
+	{"watch_migration", "WatchMigration", "Apple Watch Migration"},
+	{"update_completed", "UpdateCompleted", "Update Completed"},
+	{"appearance", "Appearance", "Appearance"},
+	{"android", "Android", "Move from Android"},
+	{"payment", "Payment", "Apple Pay"},
+	{"onboarding", "OnBoarding", "Onboarding"},
+	{"tos", "TOS", "Terms and Conditions"},
+	{"welcome", "Welcome", "Get Started"},
+	{"action_button", "ActionButton", "Action Button"},
+	{"tap_to_setup", "TapToSetup", "Tap to Set Up"},
+}
+
+// skipSetupItemsSchema returns the schema of the skip_setup_items block, one optional flag per pane.
+func skipSetupItemsSchema() map[string]*schema.Schema {
+	items := make(map[string]*schema.Schema, len(skipSetupItems))
+	for _, item := range skipSetupItems {
+		items[item.attribute] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Skip the " + item.pane + " pane.",
+		}
+	}
+	return items
+}
+
+// ResourceJamfProMobileDevicePrestageEnrollment defines the schema for managing Jamf Pro Mobile Device Prestages in Terraform.
+func ResourceJamfProMobileDevicePrestageEnrollment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the mobile device prestage.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the mobile device prestage.",
+			},
+			"device_enrollment_program_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Automated Device Enrollment instance the prestage belongs to.",
+			},
+			"mandatory": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates whether enrollment is mandatory, so users cannot skip it.",
+			},
+			"mdm_removable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the MDM profile is removable.",
+			},
+			"supervised": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if devices are supervised.",
+			},
+			"allow_pairing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if supervised devices can be paired with a computer.",
+			},
+			"support_phone_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Support phone number for the organization.",
+			},
+			"support_email_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Support email address for the organization.",
+			},
+			"department": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The department shown during Setup Assistant.",
+			},
+			"default_prestage": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if this is the default mobile device prestage enrollment. If yes then new devices will be automatically assigned to this PreStage enrollment.",
+			},
+			"enrollment_site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The jamf pro Site ID that mobile devices will be added to during enrollment. Default is -1, aka not used.",
+			},
+			"keep_existing_site_membership": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if existing device site membership should be retained.",
+			},
+			"keep_existing_location_information": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if existing device location information should be retained.",
+			},
+			"require_authentication": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the user is required to authenticate during enrollment.",
+			},
+			"authentication_prompt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The authentication prompt message displayed to the user during enrollment.",
+			},
+			"prevent_activation_lock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if activation lock should be prevented.",
+			},
+			"enable_device_based_activation_lock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if device-based activation lock should be enabled.",
+			},
+			"skip_setup_items": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Selected items are not displayed in the Setup Assistant during iOS and iPadOS device setup within Apple Device Enrollment (ADE).",
+				Elem: &schema.Resource{
+					Schema: skipSetupItemsSchema(),
+				},
+			},
+			"location_information": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Location information assigned to mobile devices enrolled with the prestage.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The username for the location information.",
+						},
+						"realname": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The real name associated with this location.",
+						},
+						"phone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The phone number associated with this location.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The email address associated with this location.",
+						},
+						"room": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The room associated with this location.",
+						},
+						"position": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The position associated with this location.",
+						},
+						"department_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "-1",
+							Description: "The department ID associated with this location.",
+						},
+						"building_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "-1",
+							Description: "The building ID associated with this location.",
+						},
+					},
+				},
+			},
+			"purchasing_information": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Purchasing information assigned to mobile devices enrolled with the prestage.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"leased": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Indicates if the item is leased.",
+						},
+						"purchased": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Indicates if the item is purchased.",
+						},
+						"apple_care_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The AppleCare ID.",
+						},
+						"po_number": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The purchase order number.",
+						},
+						"vendor": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The vendor name.",
+						},
+						"purchase_price": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The purchase price.",
+						},
+						"life_expectancy": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The life expectancy in years.",
+						},
+						"purchasing_account": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The purchasing account.",
+						},
+						"purchasing_contact": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The purchasing contact.",
+						},
+						"lease_date": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The lease date.",
+						},
+						"po_date": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The purchase order date.",
+						},
+						"warranty_date": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The warranty date.",
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "How enrolled mobile devices are named. Devices keep their default names if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_names_using": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The naming method: `Default Names`, `List of Names`, `Serial Numbers` or `Single Name`.",
+							ValidateFunc: validation.StringInSlice([]string{"Default Names", "List of Names", "Serial Numbers", "Single Name"}, false),
+						},
+						"device_names": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names given to enrolled devices, in order, when `assign_names_using` is `List of Names`.",
+						},
+						"device_name_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prefix of device names, e.g. `iPad-` in `iPad-DMPXK2ABCDEF` when `assign_names_using` is `Serial Numbers`.",
+						},
+						"device_name_suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The suffix of device names when `assign_names_using` is `Serial Numbers`.",
+						},
+						"single_device_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name given to every enrolled device when `assign_names_using` is `Single Name`.",
+						},
+						"manage_names": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Indicates if Jamf Pro enforces the assigned name, renaming devices whose users change it.",
+						},
+					},
+				},
+			},
+			"anchor_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of Base64 encoded PEM Certificates.",
+			},
+			"enrollment_customization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "0",
+				Description: "The enrollment customization ID.",
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The language setting.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region setting.",
+			},
+			"auto_advance_setup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if setup should auto-advance, for devices enrolled without a user such as Apple TVs.",
+			},
+			"configure_device_before_setup_assistant": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if configuration profiles are installed before the Setup Assistant completes.",
+			},
+			"send_timezone": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the time zone is set on enrolled devices.",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The time zone set on enrolled devices when `send_timezone` is true, e.g. `Europe/London`.",
+			},
+			"multi_user": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if iPads enroll as Shared iPads, used by several users in turn.",
+			},
+			"maximum_shared_accounts": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The maximum number of users whose data a Shared iPad keeps.",
+			},
+			"use_storage_quota_size": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if each user of a Shared iPad gets the storage quota of `storage_quota_size_megabytes`.",
+			},
+			"storage_quota_size_megabytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The storage quota of each user of a Shared iPad, in megabytes.",
+			},
+			"temporary_session_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if Shared iPads only offer temporary sessions, whose data is deleted on sign out.",
+			},
+			"enforce_temporary_session_timeout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if temporary sessions on Shared iPads end after `temporary_session_timeout`.",
+			},
+			"temporary_session_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The idle time in seconds after which a temporary session on a Shared iPad ends.",
+			},
+			"enforce_user_session_timeout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if users of Shared iPads are signed out after `user_session_timeout`.",
+			},
+			"user_session_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The idle time in seconds after which a user of a Shared iPad is signed out.",
+			},
+			"assigned_device_serial_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The serial numbers of the devices in the scope of the prestage. The scope is left as it is in Jamf Pro if not set.",
+			},
+			"profile_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The profile UUID.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The site ID.",
+			},
+			"version_lock": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version lock. Updates always carry the version lock the prestage holds in Jamf Pro at the time.",
+			},
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// mobiledeviceprestageenrollments_state.go
+package mobiledeviceprestageenrollments
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Mobile Device Prestage Enrollment information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *resourceMobileDevicePrestage) diag.Diagnostics {
+	prestageAttributes := map[string]interface{}{
+		"display_name":                            resp.DisplayName,
+		"mandatory":                               resp.Mandatory,
+		"mdm_removable":                           resp.MdmRemovable,
+		"support_phone_number":                    resp.SupportPhoneNumber,
+		"support_email_address":                   resp.SupportEmailAddress,
+		"department":                              resp.Department,
+		"default_prestage":                        resp.DefaultPrestage,
+		"enrollment_site_id":                      resp.EnrollmentSiteID,
+		"keep_existing_site_membership":           resp.KeepExistingSiteMembership,
+		"keep_existing_location_information":      resp.KeepExistingLocationInformation,
+		"require_authentication":                  resp.RequireAuthentication,
+		"authentication_prompt":                   resp.AuthenticationPrompt,
+		"prevent_activation_lock":                 resp.PreventActivationLock,
+		"enable_device_based_activation_lock":     resp.EnableDeviceBasedActivationLock,
+		"device_enrollment_program_instance_id":   resp.DeviceEnrollmentProgramInstanceID,
+		"anchor_certificates":                     resp.AnchorCertificates,
+		"enrollment_customization_id":             resp.EnrollmentCustomizationID,
+		"language":                                resp.Language,
+		"region":                                  resp.Region,
+		"auto_advance_setup":                      resp.AutoAdvanceSetup,
+		"allow_pairing":                           resp.AllowPairing,
+		"multi_user":                              resp.MultiUser,
+		"supervised":                              resp.Supervised,
+		"maximum_shared_accounts":                 resp.MaximumSharedAccounts,
+		"configure_device_before_setup_assistant": resp.ConfigureDeviceBeforeSetupAssistant,
+		"send_timezone":                           resp.SendTimezone,
+		"timezone":                                resp.Timezone,
+		"storage_quota_size_megabytes":            resp.StorageQuotaSizeMegabytes,
+		"use_storage_quota_size":                  resp.UseStorageQuotaSize,
+		"temporary_session_only":                  resp.TemporarySessionOnly,
+		"enforce_temporary_session_timeout":       resp.EnforceTemporarySessionTimeout,
+		"temporary_session_timeout":               resp.TemporarySessionTimeout,
+		"enforce_user_session_timeout":            resp.EnforceUserSessionTimeout,
+		"user_session_timeout":                    resp.UserSessionTimeout,
+		"profile_uuid":                            resp.ProfileUUID,
+		"site_id":                                 resp.SiteID,
+		"version_lock":                            resp.VersionLock,
+	}
+
+	skipped := make(map[string]interface{}, len(skipSetupItems))
+	for _, item := range skipSetupItems {
+		skipped[item.attribute] = resp.SkipSetupItems[item.key]
+	}
+	prestageAttributes["skip_setup_items"] = []interface{}{skipped}
+
+	location := resp.LocationInformation
+	prestageAttributes["location_information"] = []interface{}{
+		map[string]interface{}{
+			"username":      location.Username,
+			"realname":      location.Realname,
+			"phone":         location.Phone,
+			"email":         location.Email,
+			"room":          location.Room,
+			"position":      location.Position,
+			"department_id": location.DepartmentID,
+			"building_id":   location.BuildingID,
+		},
+	}
+
+	purchasing := resp.PurchasingInformation
+	prestageAttributes["purchasing_information"] = []interface{}{
+		map[string]interface{}{
+			"leased":             purchasing.Leased,
+			"purchased":          purchasing.Purchased,
+			"apple_care_id":      purchasing.AppleCareID,
+			"po_number":          purchasing.PONumber,
+			"vendor":             purchasing.Vendor,
+			"purchase_price":     purchasing.PurchasePrice,
+			"life_expectancy":    purchasing.LifeExpectancy,
+			"purchasing_account": purchasing.PurchasingAccount,
+			"purchasing_contact": purchasing.PurchasingContact,
+			"lease_date":         purchasing.LeaseDate,
+			"po_date":            purchasing.PODate,
+			"warranty_date":      purchasing.WarrantyDate,
+		},
+	}
+
+	// Devices keep their default names unless naming was configured.
+	prestageAttributes["names"] = []interface{}{}
+	if names := resp.Names; names.DeviceNamingConfigured {
+		deviceNames := make([]string, 0, len(names.PrestageDeviceNames))
+		for _, name := range names.PrestageDeviceNames {
+			deviceNames = append(deviceNames, name.DeviceName)
+		}
+		prestageAttributes["names"] = []interface{}{
+			map[string]interface{}{
+				"assign_names_using": names.AssignNamesUsing,
+				"device_names":       deviceNames,
+				"device_name_prefix": names.DeviceNamePrefix,
+				"device_name_suffix": names.DeviceNameSuffix,
+				"single_device_name": names.SingleDeviceName,
+				"manage_names":       names.ManageNames,
+			},
+		}
+	}
+
+	for key, val := range prestageAttributes {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// updateScopeState updates the Terraform state with the serial numbers in the scope of the prestage.
+func updateScopeState(d *schema.ResourceData, scope *mobileDevicePrestageScope) diag.Diagnostics {
+	serialNumbers := make([]string, 0, len(scope.Assignments))
+	for _, assignment := range scope.Assignments {
+		serialNumbers = append(serialNumbers, assignment.SerialNumber)
+	}
+
+	if err := d.Set("assigned_device_serial_numbers", serialNumbers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -2,16 +2,17 @@
 package networksegments
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProNetworkSegment constructs a ResourceNetworkSegment object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceNetworkSegment, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceNetworkSegment, error) {
 	resource := &jamfpro.ResourceNetworkSegment{
 		Name:                d.Get("name").(string),
 		StartingAddress:     d.Get("starting_address").(string),
@@ -32,7 +33,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceNetworkSegment, error)
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Network Segment '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Network Segment XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Network Segment XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
@@ -2,43 +2,54 @@
 package packages
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log"
-	"mime"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/externaltransport"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProPackageCreate constructs a ResourcePackage object from the provided schema data.
 // It extracts the filename from the full path provided in the schema and uses it for the FileName field.
 // If the full path is a URL, it downloads the file and uses the downloaded file path.
+// If a checksum is configured, the local or downloaded file must match it.
 // The function returns the constructed ResourcePackage, the local file path, and an error if any.
-func construct(d *schema.ResourceData) (*jamfpro.ResourcePackage, string, error) {
+func construct(ctx context.Context, d *schema.ResourceData, meta interface{}) (*jamfpro.ResourcePackage, string, error) {
 	fullPath := d.Get("package_file_source").(string)
 	var fileName string
 	var localFilePath string
 	var err error
 
 	if strings.HasPrefix(fullPath, "http") {
-		log.Printf("[INFO] URL detected: %s. Attempting to download.", fullPath)
-		localFilePath, err = downloadFile(fullPath)
+		logging.Infof(ctx, logging.SubsystemCRUD, "URL detected: %s. Attempting to download.", fullPath)
+		localFilePath, err = downloadFile(ctx, fullPath, constructDownloadOptions(d, meta))
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to download file: %v", err)
 		}
 		fileName = filepath.Base(localFilePath)
-		log.Printf("[INFO] Successfully downloaded file from URL: %s", fullPath)
+		logging.Infof(ctx, logging.SubsystemCRUD, "Successfully downloaded file from URL: %s", fullPath)
 	} else {
 		fileName = filepath.Base(fullPath)
 		localFilePath = fullPath
 	}
 
+	if checksum := d.Get("checksum").(string); checksum != "" {
+		if err := verifyChecksum(localFilePath, checksum); err != nil {
+			// Drop the cached download so a corrected file is fetched on the next run.
+			if localFilePath != fullPath {
+				os.Remove(localFilePath)
+			}
+			return nil, "", fmt.Errorf("refusing to upload unverified package file: %v", err)
+		}
+		logging.Infof(ctx, logging.SubsystemCRUD, "Verified checksum of package file: %s", localFilePath)
+	}
+
 	// Construct the ResourcePackage struct from the Terraform schema data
 	resource := &jamfpro.ResourcePackage{
 		PackageName:          d.Get("package_name").(string),
@@ -76,7 +87,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourcePackage, string, error)
 		return nil, "", fmt.Errorf("failed to marshal Jamf Pro Package '%s' to JSON: %v", resource.FileName, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Package JSON:\n%s\n", string(resourceJSON))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Package JSON:\n%s", string(resourceJSON))
 
 	return resource, localFilePath, nil
 }
@@ -86,61 +97,25 @@ func BoolPtr(b bool) *bool {
 	return &b
 }
 
-// downloadFile downloads a file from the given URL and saves it to a temporary file.
-// If the Content-Disposition header is present in the response, it uses the filename
-// from the header. Otherwise, if no filename is provided in the headers, it uses the
-// final URL after any redirects to determine the filename. It also replaces any '%' characters
-// in the filename with '_'.
-func downloadFile(url string) (string, error) {
-	tmpFile, err := os.CreateTemp("", "downloaded-*")
-	if err != nil {
-		return "", fmt.Errorf("failed to create temporary file: %v", err)
-	}
-	defer tmpFile.Close()
-
-	client := &http.Client{
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			if len(via) >= 10 {
-				return fmt.Errorf("too many redirects when attempting to download file from %s", url)
-			}
-			return nil
-		},
-	}
-
-	resp, err := client.Get(url)
-	if err != nil {
-		return "", fmt.Errorf("failed to download file from %s: %v", url, err)
+// constructDownloadOptions builds the options used to download package_file_source URLs from the schema data and
+// the network settings of the provider.
+func constructDownloadOptions(d *schema.ResourceData, meta interface{}) downloadOptions {
+	options := downloadOptions{
+		cacheDir:     d.Get("download_cache_directory").(string),
+		checksum:     d.Get("checksum").(string),
+		cacheMaxSize: int64(d.Get("download_cache_max_size_mb").(int)) << 20,
+		maxSize:      int64(d.Get("download_max_size_bytes").(int)),
+		headers:      make(map[string]string),
+		transport:    externaltransport.Clone(meta),
 	}
-	defer resp.Body.Close()
 
-	_, err = io.Copy(tmpFile, resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("failed to write to temporary file: %v", err)
+	if options.cacheDir == "" {
+		options.cacheDir = defaultDownloadCacheDir()
 	}
 
-	// Get the file name from the Content-Disposition header if available
-	_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition"))
-	if err == nil {
-		if filename, ok := params["filename"]; ok {
-			filename = strings.ReplaceAll(filename, "%", "_")
-			finalPath := filepath.Join(os.TempDir(), filename)
-			err = os.Rename(tmpFile.Name(), finalPath)
-			if err != nil {
-				return "", fmt.Errorf("failed to rename temporary file to final destination: %v", err)
-			}
-			log.Printf("[INFO] File downloaded to: %s", finalPath)
-			return finalPath, nil
-		}
+	for key, value := range d.Get("download_headers").(map[string]interface{}) {
+		options.headers[key] = value.(string)
 	}
 
-	finalURL := resp.Request.URL.String()
-	fileName := filepath.Base(finalURL)
-	fileName = strings.ReplaceAll(fileName, "%", "_")
-	finalPath := filepath.Join(os.TempDir(), fileName)
-	err = os.Rename(tmpFile.Name(), finalPath)
-	if err != nil {
-		return "", fmt.Errorf("failed to rename temporary file to final destination: %v", err)
-	}
-	log.Printf("[INFO] File downloaded to: %s", finalPath)
-	return finalPath, nil
+	return options
 }
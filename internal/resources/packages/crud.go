@@ -4,12 +4,10 @@ package packages
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -18,56 +16,74 @@ import (
 
 // create is responsible for creating a new Jamf Pro Package in the remote system.
 // The function:
-// 1. Constructs the attribute data using the provided Terraform configuration.
-// 2. Calls the API to create the package metadata in jamfpro.
-// 3. Uploads the package file to the Jamf Pro server.
-// 4. Sets the ID of the created package in the Terraform state.
-// 5. Perform cleanup of downloaded package if it was from an HTTP(s) source
+// 1. Checks for an existing package with the same name and adopts it or fails, depending on reconciliation_mode.
+// 2. Constructs the attribute data using the provided Terraform configuration.
+// 3. Calls the API to create the package metadata in jamfpro.
+// 4. Sets the ID of the created package in the Terraform state, so that a failed upload taints it.
+// 5. Uploads the package file to the Jamf Pro server, once, outside of the retries of the create.
 // 6. Reads the created package to ensure the Terraform state is up-to-date.
 func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, localFilePath, err := construct(d)
+	packageName := d.Get("package_name").(string)
+	existing, err := findExistingPackage(ctx, d, client, packageName)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Package: %v", err))
+		return diag.FromErr(err)
 	}
 
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		var apiErr error
-		var creationResponse *jamfpro.ResponsePackageCreatedAndUpdated
-
-		creationResponse, apiErr = client.CreatePackage(*resource)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+	if existing != nil {
+		if d.Get("reconciliation_mode").(string) != reconciliationModeAdopt {
+			return diag.Errorf("a Jamf Pro Package named '%s' already exists (ID: %s) and is not managed by this resource. "+
+				"Import it with 'terraform import' or set reconciliation_mode = \"%s\" to take it under management",
+				packageName, existing.ID, reconciliationModeAdopt)
 		}
 
-		log.Printf("[DEBUG] Jamf Pro Package Metadata created: %+v", creationResponse)
+		logging.Infof(ctx, logging.SubsystemCRUD, "Adopting existing Jamf Pro Package '%s' (ID: %s)", packageName, existing.ID)
 
-		fullFilePath := localFilePath
+		// Compare against the file Jamf Pro holds, so that the file is only uploaded again if it differs.
+		d.SetId(existing.ID)
 
-		_, apiErr = client.UploadPackage(creationResponse.ID, []string{fullFilePath})
-		if apiErr != nil {
-			log.Printf("[ERROR] Failed to upload package file for package '%s': %v", creationResponse.ID, apiErr)
-			return retry.NonRetryableError(apiErr)
-		}
+		return append(diags, updatePackage(ctx, d, meta, existing.MD5)...)
+	}
 
-		d.SetId(creationResponse.ID)
+	resource, localFilePath, err := construct(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Package: %v", err))
+	}
 
+	fileHashes, err := generateFileHashes(localFilePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var creationResponse *jamfpro.ResponsePackageCreatedAndUpdated
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var apiErr error
+		creationResponse, apiErr = client.CreatePackage(*resource)
+		if apiErr != nil {
+			return common.RetryOnError(ctx, apiErr)
+		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create and upload Jamf Pro Package '%s' after retries: %v", resource.PackageName, err))
+		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Package '%s' after retries: %v", resource.PackageName, err))
 	}
 
-	if strings.HasPrefix(d.Get("package_file_source").(string), "http") {
-		err := os.Remove(localFilePath)
-		if err != nil {
-			log.Printf("[WARN] Failed to remove downloaded package file '%s': %v", localFilePath, err)
-		} else {
-			log.Printf("[INFO] Successfully removed downloaded package file '%s'", localFilePath)
-		}
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Jamf Pro Package Metadata created: %+v", creationResponse)
+
+	// The package is tracked as soon as it exists, so a failed upload taints it rather than leaving a package
+	// Terraform does not know about.
+	d.SetId(creationResponse.ID)
+
+	if _, err := client.UploadPackage(creationResponse.ID, []string{localFilePath}); err != nil {
+		logging.Errorf(ctx, logging.SubsystemCRUD, "Failed to upload package file for package '%s': %v", creationResponse.ID, err)
+		return diag.FromErr(fmt.Errorf("failed to upload package file for Jamf Pro Package '%s' (ID: %s): %v", resource.PackageName, creationResponse.ID, err))
+	}
+
+	for key, val := range fileHashes {
+		d.Set(key, val)
 	}
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
@@ -85,7 +101,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup
 		var apiErr error
 		response, apiErr = client.GetPackageByID(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -108,12 +124,20 @@ func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}
 }
 
 // update is responsible for updating an existing Jamf Pro Package on the remote system.
+// The hash planned for md5_file_hash may already be the new one, so the file is compared against the hash in state.
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	previousFileHash, _ := d.GetChange("md5_file_hash")
+	return updatePackage(ctx, d, meta, previousFileHash.(string))
+}
+
+// updatePackage updates the metadata of a Jamf Pro Package and uploads the package file if its MD5 hash
+// differs from previousFileHash.
+func updatePackage(ctx context.Context, d *schema.ResourceData, meta interface{}, previousFileHash string) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, localFilePath, err := construct(d)
+	resource, localFilePath, err := construct(ctx, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Package for update: %v", err))
 	}
@@ -121,7 +145,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := client.UpdatePackageByID(resourceID, *resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return common.RetryOnError(ctx, apiErr)
 		}
 		return nil
 	})
@@ -131,35 +155,30 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	}
 
 	// Use the local file path for generating the file hash
-	newFileHash, err := generateMD5FileHash(localFilePath)
+	fileHashes, err := generateFileHashes(localFilePath)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to generate file hash for %s: %v", localFilePath, err))
+		return diag.FromErr(err)
 	}
 
-	oldFileHash, _ := d.Get("md5_file_hash").(string)
+	newFileHash := fileHashes["md5_file_hash"]
 
-	log.Printf("[DEBUG] Comparing MD5 hashes for package update: oldFileHash=%s, newFileHash=%s", oldFileHash, newFileHash)
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Comparing MD5 hashes for package update: oldFileHash=%s, newFileHash=%s", previousFileHash, newFileHash)
 
-	if newFileHash != oldFileHash {
+	if newFileHash != previousFileHash {
 		_, err = client.UploadPackage(resourceID, []string{localFilePath})
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to upload package file for package '%s': %v", resourceID, err))
 		}
 
-		// Update the filename and md5_file_hash in Terraform state to reflect the new file
+		// Update the filename in Terraform state to reflect the new file
 		// this is done here while jamf JCDS hashes the file and updates the package metadata
 		// to ensure that any runs during this window doesnt trigger another file upload.
-		d.Set("md5_file_hash", newFileHash)
 		d.Set("filename", filepath.Base(localFilePath))
 	}
 
-	if strings.HasPrefix(d.Get("package_file_source").(string), "http") {
-		err := os.Remove(localFilePath)
-		if err != nil {
-			log.Printf("[WARN] Failed to remove downloaded package file '%s': %v", localFilePath, err)
-		} else {
-			log.Printf("[INFO] Successfully removed downloaded package file '%s'", localFilePath)
-		}
+	// The file hashes are always stated, as the plan may have left them unknown.
+	for key, val := range fileHashes {
+		d.Set(key, val)
 	}
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
@@ -173,19 +192,12 @@ func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := client.DeletePackageByID(resourceID)
-		if apiErr != nil {
-			resourceName := d.Get("name").(string)
-			apiErrByName := client.DeleteScriptByName(resourceName)
-			if apiErrByName != nil {
-				return retry.RetryableError(apiErrByName)
-			}
-		}
-
-		return nil
+		return common.RetryOnError(ctx, apiErr)
 	})
 
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Script '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+	// A package which no longer exists has already reached the desired state.
+	if err != nil && !common.IsNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Package '%s' (ID: %s) after retries: %v", d.Get("package_name").(string), resourceID, err))
 	}
 
 	d.SetId("")
@@ -4,12 +4,12 @@ package packages
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
-
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,68 +22,107 @@ func DataSourceJamfProPackages() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the Jamf Pro site.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the package. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique name of the Jamf Pro site.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the package. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific Jamf Pro package
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'name' attribute over the 'id'
-// attribute for fetching details. If neither 'name' nor 'id' is provided, it returns an error.
-// Once the details are fetched, they are set in the data source's state.
-//
-// Parameters:
-// - ctx: The context within which the function is called. It's used for timeouts and cancellation.
-// - d: The current state of the data source.
-// - meta: The meta object that can be used to retrieve the API client connection.
-//
-// Returns:
-// - diag.Diagnostics: Returns any diagnostics (errors or warnings) encountered during the function's execution.
+// dataSourceRead fetches the details of a specific package from Jamf Pro using either its unique name or its ID.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*jamfpro.Client)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.client")
-	}
-
-	// Initialize variables
-	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
+	client := meta.(*jamfpro.Client)
 
-	var resource *jamfpro.ResourcePackage
+	// The package list serves both the uniqueness check and the lookup by name, so it is fetched once.
+	var packages *jamfpro.ResponsePackagesList
+	listPackages := func() (*jamfpro.ResponsePackagesList, error) {
+		if packages != nil {
+			return packages, nil
+		}
 
-	// Read operation with retry
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = client.GetPackageByID(resourceID)
-		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+		response, err := client.GetPackages("", "")
+		if err != nil {
+			return nil, err
 		}
+		packages = response
+		return packages, nil
+	}
+
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Package",
+		"name",
+		client.GetPackageByID,
+		func(name string) (*jamfpro.ResourcePackage, error) {
+			response, err := listPackages()
+			if err != nil {
+				return nil, err
+			}
+			return findByName(response, name)
+		},
+		func() ([]string, error) {
+			response, err := listPackages()
+			if err != nil {
+				return nil, err
+			}
+			return listNames(response), nil
+		},
+		dataSourceUpdateState,
+	)
+}
+
+// listNames returns the names of all packages in a package list.
+func listNames(response *jamfpro.ResponsePackagesList) []string {
+	names := make([]string, 0, len(response.Results))
+	for _, item := range response.Results {
+		names = append(names, item.PackageName)
+	}
 
-		return nil
-	})
+	return names
+}
 
+// getByName returns the package with the given name. The SDK has no lookup by name for this type.
+func getByName(client *jamfpro.Client, name string) (*jamfpro.ResourcePackage, error) {
+	response, err := client.GetPackages("", "")
 	if err != nil {
-		// Handle the final error after all retries have been exhausted
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Package with ID '%s' after retries: %v", resourceID, err))
+		return nil, err
 	}
 
-	// Check if resource data exists and set the Terraform state
-	if resource != nil {
-		d.SetId(resourceID) // Confirm the ID in the Terraform state
-		if err := d.Set("package_name", resource.PackageName); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Package with ID '%s': %v", resourceID, err))...)
+	return findByName(response, name)
+}
+
+// findByName returns the package of a package list with the given name, or a not found error if there is none.
+func findByName(response *jamfpro.ResponsePackagesList, name string) (*jamfpro.ResourcePackage, error) {
+	for _, item := range response.Results {
+		if item.PackageName == name {
+			return &item, nil
 		}
-	} else {
-		d.SetId("") // Data not found, unset the ID in the Terraform state
+	}
+
+	return nil, &common.APIError{
+		StatusCode: http.StatusNotFound,
+		Err:        fmt.Errorf("failed to get package by name: %s, error: no package with this name exists", name),
+	}
+}
+
+// dataSourceUpdateState updates the Terraform state with the package returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourcePackage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(resource.ID)
+	if err := d.Set("name", resource.PackageName); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
@@ -0,0 +1,83 @@
+// packages_data_source_list.go
+package packages
+
+import (
+	"context"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the packages list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "package",
+	Category:         true,
+	Attributes: map[string]*schema.Schema{
+		"filename": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The filename of the package.",
+		},
+	},
+}
+
+// DataSourceJamfProPackagesList provides the packages in Jamf Pro, optionally filtered by name or category.
+func DataSourceJamfProPackagesList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the packages in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		nil,
+	)
+}
+
+// listItems returns all packages in Jamf Pro as list items. Packages only reference their category by ID,
+// so the category names are resolved from a single list of all categories.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	categories, err := client.GetCategories("")
+	if err != nil {
+		return nil, err
+	}
+
+	categoryNames := make(map[string]string, len(categories.Results))
+	for _, category := range categories.Results {
+		categoryNames[category.Id] = category.Name
+	}
+
+	response, err := client.GetPackages("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.Results))
+	for _, item := range response.Results {
+		items = append(items, common.ListItem{
+			ID:       item.ID,
+			Name:     item.PackageName,
+			Category: categoryNames[item.CategoryID],
+			Attributes: map[string]interface{}{
+				"filename": item.FileName,
+			},
+		})
+	}
+
+	return items, nil
+}
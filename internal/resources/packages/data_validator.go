@@ -3,35 +3,191 @@ package packages
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/externaltransport"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // customValidateFilePath is a custom validation function for the package_file_source field.
-// It ensures that the package_file_source field ends with .dmg if fill_user_template or fill_existing_users are set to true.
+// It ensures that the package_file_source field ends with .dmg if fill_user_template or fill_existing_users are set to true,
+// and plans a re-upload when the package file differs from the one last uploaded.
 func customValidateFilePath(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	filePath, ok := d.Get("package_file_source").(string)
 	if !ok {
 		return fmt.Errorf("invalid type for package_file_sourceh")
 	}
 
-	if strings.HasSuffix(filePath, ".dmg") {
+	if d.NewValueKnown("package_file_source") && !strings.HasSuffix(filePath, ".dmg") {
+		// If file path does not end with .dmg, ensure fill_user_template and fill_existing_users are not set to true
+		fillUserTemplate, fillUserTemplateOk := d.GetOk("fill_user_template")
+		fillExistingUsers, fillExistingUsersOk := d.GetOk("fill_existing_users")
+
+		if fillUserTemplateOk && fillUserTemplate.(bool) {
+			return fmt.Errorf("fill_user_template can only be set to true if the package defined in package_file_source ends with .dmg")
+		}
+
+		if fillExistingUsersOk && fillExistingUsers.(bool) {
+			return fmt.Errorf("fill_existing_users can only be set to true if the package defined in package_file_source ends with .dmg")
+		}
+	}
+
+	return planPackageFileChange(ctx, d, meta)
+}
+
+// planPackageFileChange compares the hash of the package file source with the hash of the file last uploaded and
+// marks md5_file_hash, sha256_file_hash and filename as changing when they differ, so the plan shows the re-upload.
+// Local files are hashed directly, while an unknown source or a missing local file is planned as changing without
+// failing the plan. URLs are never downloaded during plan, their hash is taken from the configured
+// sha256 checksum, a cached download matching the checksum, or checksum headers advertised in a HEAD response.
+func planPackageFileChange(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A new package has no uploaded file to compare against.
+	if d.Id() == "" {
 		return nil
 	}
 
-	// If file path does not end with .dmg, ensure fill_user_template and fill_existing_users are not set to true
-	fillUserTemplate, fillUserTemplateOk := d.GetOk("fill_user_template")
-	fillExistingUsers, fillExistingUsersOk := d.GetOk("fill_existing_users")
+	// A source known only on apply, e.g. the output of another resource, cannot be compared yet.
+	if !d.NewValueKnown("package_file_source") {
+		logging.Debugf(ctx, logging.SubsystemCRUD, "package_file_source is unknown during plan, the package file is compared on apply")
+		return markPackageFileChanging(d, "", "", "")
+	}
+
+	source := d.Get("package_file_source").(string)
+	oldMD5Hash := d.Get("md5_file_hash").(string)
+	oldSHA256Hash := d.Get("sha256_file_hash").(string)
+
+	if !strings.HasPrefix(source, "http") {
+		// The file may only be produced by an earlier step of the apply, so its absence does not fail the plan.
+		if _, err := os.Stat(source); errors.Is(err, fs.ErrNotExist) {
+			logging.Infof(ctx, logging.SubsystemCRUD, "Package file %s does not exist yet, the package file is compared on apply", source)
+			return markPackageFileChanging(d, "", "", "")
+		}
+
+		md5Hash, err := generateMD5FileHash(source)
+		if err != nil {
+			return fmt.Errorf("failed to hash package_file_source: %v", err)
+		}
+
+		if md5Hash == oldMD5Hash {
+			return nil
+		}
+
+		logging.Infof(ctx, logging.SubsystemCRUD, "Package file %s has changed, planning a re-upload", source)
+		return markPackageFileChanging(d, md5Hash, "", filepath.Base(source))
+	}
+
+	md5Hash, sha256Hash := remoteFileHashes(ctx, d, meta, source)
+
+	switch {
+	case sha256Hash != "" && oldSHA256Hash != "":
+		if strings.EqualFold(sha256Hash, oldSHA256Hash) {
+			return nil
+		}
+	case md5Hash != "":
+		if strings.EqualFold(md5Hash, oldMD5Hash) {
+			return nil
+		}
+	case d.HasChanges("package_file_source", "checksum"):
+		// Nothing advertises the hash of the new source, so its content can only be assumed to change.
+	default:
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No hash is available for %s during plan, the package file is compared on apply", source)
+		return nil
+	}
+
+	logging.Infof(ctx, logging.SubsystemCRUD, "Package file %s has changed, planning a re-upload", source)
+	return markPackageFileChanging(d, strings.ToLower(md5Hash), strings.ToLower(sha256Hash), "")
+}
 
-	if fillUserTemplateOk && fillUserTemplate.(bool) {
-		return fmt.Errorf("fill_user_template can only be set to true if the package defined in package_file_source ends with .dmg")
+// markPackageFileChanging plans new values for the attributes describing the uploaded package file.
+// Empty values are planned as unknown.
+func markPackageFileChanging(d *schema.ResourceDiff, md5Hash string, sha256Hash string, fileName string) error {
+	planned := map[string]string{
+		"md5_file_hash":    md5Hash,
+		"sha256_file_hash": sha256Hash,
+		"filename":         fileName,
 	}
 
-	if fillExistingUsersOk && fillExistingUsers.(bool) {
-		return fmt.Errorf("fill_existing_users can only be set to true if the package defined in package_file_source ends with .dmg")
+	for key, val := range planned {
+		var err error
+		if val == "" {
+			err = d.SetNewComputed(key)
+		} else {
+			err = d.SetNew(key, val)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to plan '%s': %v", key, err)
+		}
 	}
 
 	return nil
 }
+
+// remoteFileHashes returns the MD5 and SHA-256 hashes of the file at a package_file_source URL as far as they
+// are known without downloading it. Either may be empty.
+func remoteFileHashes(ctx context.Context, d *schema.ResourceDiff, meta interface{}, source string) (string, string) {
+	checksum := d.Get("checksum").(string)
+	if algorithm, digest, found := strings.Cut(checksum, ":"); found && algorithm == "sha256" {
+		return "", digest
+	}
+
+	if checksum != "" {
+		cacheDir := d.Get("download_cache_directory").(string)
+		if cacheDir == "" {
+			cacheDir = defaultDownloadCacheDir()
+		}
+
+		entryDir := filepath.Join(cacheDir, downloadCacheKey(source, checksum))
+		if cachedPath := findCachedDownload(entryDir); cachedPath != "" {
+			if md5Hash, err := generateMD5FileHash(cachedPath); err == nil {
+				return md5Hash, ""
+			}
+		}
+	}
+
+	return advertisedFileHashes(ctx, d, meta, source)
+}
+
+// advertisedFileHashes sends a HEAD request for a package_file_source URL and returns the MD5 and SHA-256 hashes
+// advertised by the server, as artifact repositories commonly do. Failures are logged and ignored, as the
+// package file is compared on apply anyway.
+func advertisedFileHashes(ctx context.Context, d *schema.ResourceDiff, meta interface{}, source string) (string, string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, source, nil)
+	if err != nil {
+		logging.Warnf(ctx, logging.SubsystemCRUD, "Failed to build HEAD request for %s: %v", source, err)
+		return "", ""
+	}
+
+	for key, value := range d.Get("download_headers").(map[string]interface{}) {
+		req.Header.Set(key, value.(string))
+	}
+
+	resp, err := newDownloadClient(externaltransport.Clone(meta), source).Do(req)
+	if err != nil {
+		logging.Warnf(ctx, logging.SubsystemCRUD, "Failed to send HEAD request for %s: %v", source, err)
+		return "", ""
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logging.Warnf(ctx, logging.SubsystemCRUD, "HEAD request for %s returned %s", source, resp.Status)
+		return "", ""
+	}
+
+	md5Hash := resp.Header.Get("X-Checksum-Md5")
+	if md5Hash == "" {
+		if decoded, err := base64.StdEncoding.DecodeString(resp.Header.Get("Content-MD5")); err == nil && len(decoded) > 0 {
+			md5Hash = hex.EncodeToString(decoded)
+		}
+	}
+
+	return md5Hash, resp.Header.Get("X-Checksum-Sha256")
+}
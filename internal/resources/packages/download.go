@@ -0,0 +1,481 @@
+// packages_download.go
+package packages
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+)
+
+const (
+	// partialDownloadName is the name of an incomplete download within a cache entry.
+	partialDownloadName = "download.part"
+	// validatorsName is the name of the file holding the ETag and Last-Modified of a cache entry.
+	validatorsName = "download.validators"
+	// lockName is the name of the file locking a cache entry while it is downloaded into.
+	lockName = "download.lock"
+	// defaultDownloadName is used when neither the response nor the URL carry a usable filename.
+	defaultDownloadName = "package"
+	// defaultDownloadCacheMaxSizeMB is the size in MiB of the download cache above which entries are evicted, unless configured.
+	defaultDownloadCacheMaxSizeMB = 10 * 1024
+)
+
+const (
+	// lockPollInterval is how often a locked cache entry is checked for being released.
+	lockPollInterval = 500 * time.Millisecond
+	// lockRefreshInterval is how often the holder of a lock refreshes the modification time of its lock file.
+	lockRefreshInterval = 15 * time.Second
+	// staleLockAge is how long a lock file may go without being refreshed before its holder is presumed gone.
+	staleLockAge = 4 * lockRefreshInterval
+)
+
+// entryLocks serialise the downloads into each cache entry within the provider process, keyed by entry directory.
+// Each holds a channel with room for one token, so waiting for it can be cancelled. The lock file of an entry
+// serialises them across the provider processes sharing the cache, e.g. of aliases.
+var entryLocks sync.Map
+
+// unsafeFilenameCharacters matches every character not allowed in the filename of a downloaded package.
+var unsafeFilenameCharacters = regexp.MustCompile(`[^A-Za-z0-9._+\- ]`)
+
+// downloadOptions controls how a package_file_source URL is downloaded.
+type downloadOptions struct {
+	// cacheDir is the directory holding one entry per URL and checksum.
+	cacheDir string
+	// checksum is the expected checksum of the file. With a checksum a cached file is reused without contacting the server.
+	checksum string
+	// cacheMaxSize is the size in bytes above which the least recently used cache entries are evicted, 0 for no limit.
+	cacheMaxSize int64
+	// maxSize is the largest file in bytes which may be downloaded, 0 for no limit.
+	maxSize int64
+	// headers are added to every request, for example to authenticate against an artifact repository.
+	headers map[string]string
+	// transport carries the proxy and certificate authorities of the provider.
+	transport *http.Transport
+}
+
+// defaultDownloadCacheDir returns the cache directory used when download_cache_directory is not set.
+func defaultDownloadCacheDir() string {
+	return filepath.Join(os.TempDir(), "terraform-provider-jamfpro", "packages")
+}
+
+// downloadFile downloads a file from the given URL into the download cache and returns its path.
+// Each URL and checksum pair has its own cache entry, which is locked while it is downloaded into. Once the file is
+// available, the least recently used entries are evicted until the cache fits its maximum size.
+func downloadFile(ctx context.Context, sourceURL string, options downloadOptions) (string, error) {
+	entryDir := filepath.Join(options.cacheDir, downloadCacheKey(sourceURL, options.checksum))
+	if err := os.MkdirAll(entryDir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create download cache directory %s: %v", entryDir, err)
+	}
+
+	unlock, err := lockCacheEntry(ctx, entryDir)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	downloadedPath, err := downloadIntoEntry(ctx, entryDir, sourceURL, options)
+	if err != nil {
+		return "", err
+	}
+
+	// The modification time of an entry records when it was last used.
+	now := time.Now()
+	if err := os.Chtimes(entryDir, now, now); err != nil {
+		logging.Warnf(ctx, logging.SubsystemCRUD, "Failed to mark download cache entry %s as used: %v", entryDir, err)
+	}
+
+	if options.cacheMaxSize > 0 {
+		pruneDownloadCache(ctx, options.cacheDir, entryDir, options.cacheMaxSize)
+	}
+
+	return downloadedPath, nil
+}
+
+// downloadIntoEntry downloads a file into a locked cache entry and returns its path. An entry downloaded with a
+// checksum is reused as is, otherwise it is revalidated with the server using its ETag or Last-Modified date. An
+// interrupted download is resumed with an HTTP Range request. The filename is taken from the Content-Disposition
+// header or the final URL after any redirects, and sanitised so it cannot escape the cache entry.
+func downloadIntoEntry(ctx context.Context, entryDir string, sourceURL string, options downloadOptions) (string, error) {
+	cachedPath := findCachedDownload(entryDir)
+	if cachedPath != "" && options.checksum != "" {
+		logging.Infof(ctx, logging.SubsystemCRUD, "Using cached download of %s: %s", sourceURL, cachedPath)
+		return cachedPath, nil
+	}
+
+	partialPath := filepath.Join(entryDir, partialDownloadName)
+	validatorsPath := filepath.Join(entryDir, validatorsName)
+	etag, lastModified := readValidators(validatorsPath)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build request for %s: %v", sourceURL, err)
+	}
+	for key, value := range options.headers {
+		req.Header.Set(key, value)
+	}
+
+	var offset int64
+	switch {
+	case cachedPath != "":
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	default:
+		if info, err := os.Stat(partialPath); err == nil && info.Size() > 0 && (etag != "" || lastModified != "") {
+			offset = info.Size()
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+			if etag != "" {
+				req.Header.Set("If-Range", etag)
+			} else {
+				req.Header.Set("If-Range", lastModified)
+			}
+		}
+	}
+
+	resp, err := newDownloadClient(options.transport, sourceURL).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download file from %s: %v", sourceURL, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		if cachedPath != "" {
+			logging.Infof(ctx, logging.SubsystemCRUD, "Cached download of %s is up to date: %s", sourceURL, cachedPath)
+			return cachedPath, nil
+		}
+		return "", fmt.Errorf("failed to download file from %s: unexpected 304 response", sourceURL)
+	case http.StatusPartialContent:
+		logging.Infof(ctx, logging.SubsystemCRUD, "Resuming download of %s from byte %d", sourceURL, offset)
+	case http.StatusOK:
+		offset = 0
+	default:
+		return "", fmt.Errorf("failed to download file from %s: unexpected status %s", sourceURL, resp.Status)
+	}
+
+	if options.maxSize > 0 {
+		if total := expectedDownloadSize(resp, offset); total > options.maxSize {
+			return "", fmt.Errorf("refusing to download %s: size %d bytes exceeds the maximum of %d bytes", sourceURL, total, options.maxSize)
+		}
+	}
+
+	if err := writeValidators(validatorsPath, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")); err != nil {
+		return "", err
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if offset > 0 {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	partialFile, err := os.OpenFile(partialPath, flags, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("failed to open partial download %s: %v", partialPath, err)
+	}
+
+	body := io.Reader(resp.Body)
+	if options.maxSize > 0 {
+		body = io.LimitReader(resp.Body, options.maxSize-offset+1)
+	}
+
+	written, err := io.Copy(partialFile, body)
+	if closeErr := partialFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to write download of %s, it will be resumed on the next run: %v", sourceURL, err)
+	}
+
+	if options.maxSize > 0 && offset+written > options.maxSize {
+		os.Remove(partialPath)
+		return "", fmt.Errorf("refusing to download %s: size exceeds the maximum of %d bytes", sourceURL, options.maxSize)
+	}
+
+	finalPath := filepath.Join(entryDir, downloadFileName(resp))
+	if cachedPath != "" && cachedPath != finalPath {
+		os.Remove(cachedPath)
+	}
+
+	if err := os.Rename(partialPath, finalPath); err != nil {
+		return "", fmt.Errorf("failed to rename partial download to final destination: %v", err)
+	}
+
+	logging.Infof(ctx, logging.SubsystemCRUD, "File downloaded to: %s", finalPath)
+	return finalPath, nil
+}
+
+// newDownloadClient returns the HTTP client used for package downloads, sending requests through a copy of the given
+// transport, or of the default transport of net/http if nil. It bounds connecting and waiting for response headers,
+// but not the transfer itself, as packages can be several gigabytes large.
+func newDownloadClient(transport *http.Transport, sourceURL string) *http.Client {
+	if transport == nil {
+		transport = http.DefaultTransport.(*http.Transport)
+	}
+	transport = transport.Clone()
+	transport.TLSHandshakeTimeout = 30 * time.Second
+	transport.ResponseHeaderTimeout = 2 * time.Minute
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("too many redirects when attempting to download file from %s", sourceURL)
+			}
+			return nil
+		},
+	}
+}
+
+// lockCacheEntry locks a cache entry, waiting for any other download into it to finish, and returns the function
+// releasing the lock. The lock file is refreshed while held, so the lock of a process which died is taken over.
+// Waiting stops with an error once ctx is done.
+func lockCacheEntry(ctx context.Context, entryDir string) (func(), error) {
+	value, _ := entryLocks.LoadOrStore(entryDir, make(chan struct{}, 1))
+	held := value.(chan struct{})
+	select {
+	case held <- struct{}{}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("stopped waiting for download cache entry %s: %v", entryDir, ctx.Err())
+	}
+	unlockInProcess := func() { <-held }
+
+	lockPath := filepath.Join(entryDir, lockName)
+	for {
+		lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			lockFile.Close()
+			break
+		}
+		if !os.IsExist(err) {
+			unlockInProcess()
+			return nil, fmt.Errorf("failed to lock download cache entry %s: %v", entryDir, err)
+		}
+
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > staleLockAge {
+			logging.Warnf(ctx, logging.SubsystemCRUD, "Taking over the stale lock of download cache entry %s", entryDir)
+			os.Remove(lockPath)
+			continue
+		}
+
+		timer := time.NewTimer(lockPollInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			unlockInProcess()
+			return nil, fmt.Errorf("stopped waiting for download cache entry %s: %v", entryDir, ctx.Err())
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(lockRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				os.Chtimes(lockPath, now, now)
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		os.Remove(lockPath)
+		unlockInProcess()
+	}, nil
+}
+
+// isCacheEntryLocked reports whether a download into a cache entry is in progress.
+func isCacheEntryLocked(entryDir string) bool {
+	info, err := os.Stat(filepath.Join(entryDir, lockName))
+	return err == nil && time.Since(info.ModTime()) <= staleLockAge
+}
+
+// pruneDownloadCache evicts the least recently used entries of the download cache until it holds at most maxSize
+// bytes. The entry just used and entries being downloaded into are kept, so the cache may stay above maxSize.
+func pruneDownloadCache(ctx context.Context, cacheDir string, keep string, maxSize int64) {
+	dirEntries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		logging.Warnf(ctx, logging.SubsystemCRUD, "Failed to read download cache %s: %v", cacheDir, err)
+		return
+	}
+
+	type cacheEntry struct {
+		path     string
+		size     int64
+		lastUsed time.Time
+	}
+
+	var entries []cacheEntry
+	var total int64
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() {
+			continue
+		}
+
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+
+		entry := cacheEntry{path: filepath.Join(cacheDir, dirEntry.Name()), lastUsed: info.ModTime()}
+		files, err := os.ReadDir(entry.path)
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			if fileInfo, err := file.Info(); err == nil && !file.IsDir() {
+				entry.size += fileInfo.Size()
+			}
+		}
+
+		total += entry.size
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastUsed.Before(entries[j].lastUsed)
+	})
+
+	for _, entry := range entries {
+		if total <= maxSize {
+			return
+		}
+		if entry.path == keep || isCacheEntryLocked(entry.path) {
+			continue
+		}
+
+		if err := os.RemoveAll(entry.path); err != nil {
+			logging.Warnf(ctx, logging.SubsystemCRUD, "Failed to evict download cache entry %s: %v", entry.path, err)
+			continue
+		}
+
+		logging.Infof(ctx, logging.SubsystemCRUD, "Evicted download cache entry %s (%d bytes)", entry.path, entry.size)
+		total -= entry.size
+	}
+}
+
+// downloadCacheKey returns the name of the cache entry for a URL and checksum pair.
+func downloadCacheKey(sourceURL string, checksum string) string {
+	sum := sha256.Sum256([]byte(sourceURL + "|" + strings.ToLower(checksum)))
+	return hex.EncodeToString(sum[:])
+}
+
+// findCachedDownload returns the completed download held in a cache entry, if any.
+func findCachedDownload(entryDir string) string {
+	entries, err := os.ReadDir(entryDir)
+	if err != nil {
+		return ""
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == partialDownloadName || entry.Name() == validatorsName || entry.Name() == lockName {
+			continue
+		}
+		return filepath.Join(entryDir, entry.Name())
+	}
+
+	return ""
+}
+
+// expectedDownloadSize returns the full size of the file being downloaded, or -1 if the server did not say.
+func expectedDownloadSize(resp *http.Response, offset int64) int64 {
+	if resp.StatusCode == http.StatusPartialContent {
+		contentRange := resp.Header.Get("Content-Range")
+		if i := strings.LastIndex(contentRange, "/"); i != -1 {
+			if total, err := strconv.ParseInt(contentRange[i+1:], 10, 64); err == nil {
+				return total
+			}
+		}
+	}
+
+	if resp.ContentLength < 0 {
+		return -1
+	}
+
+	return offset + resp.ContentLength
+}
+
+// readValidators returns the ETag and Last-Modified date recorded for a cache entry.
+func readValidators(validatorsPath string) (string, string) {
+	content, err := os.ReadFile(validatorsPath)
+	if err != nil {
+		return "", ""
+	}
+
+	etag, lastModified, _ := strings.Cut(string(content), "\n")
+	return etag, strings.TrimSpace(lastModified)
+}
+
+// writeValidators records the ETag and Last-Modified date of a download so it can be resumed or revalidated.
+func writeValidators(validatorsPath string, etag string, lastModified string) error {
+	if err := os.WriteFile(validatorsPath, []byte(etag+"\n"+lastModified+"\n"), 0o600); err != nil {
+		return fmt.Errorf("failed to record download validators %s: %v", validatorsPath, err)
+	}
+	return nil
+}
+
+// downloadFileName returns the sanitised filename of a download, taken from the Content-Disposition header
+// if available or from the path of the final URL after any redirects otherwise.
+func downloadFileName(resp *http.Response) string {
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		if name := sanitizeFileName(params["filename"]); name != "" {
+			return name
+		}
+	}
+
+	if resp.Request != nil && resp.Request.URL != nil {
+		if name := sanitizeFileName(lastPathSegment(resp.Request.URL)); name != "" {
+			return name
+		}
+	}
+
+	return defaultDownloadName
+}
+
+// lastPathSegment returns the unescaped last segment of the path of a URL, ignoring any query or fragment.
+func lastPathSegment(u *url.URL) string {
+	segment := path.Base(u.EscapedPath())
+	if unescaped, err := url.PathUnescape(segment); err == nil {
+		return unescaped
+	}
+	return segment
+}
+
+// sanitizeFileName reduces a server provided filename to a single safe path element. Directory components are
+// dropped, so the name cannot traverse out of the download directory, and unusual characters are replaced with '_'.
+// It returns an empty string if nothing usable remains.
+func sanitizeFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = path.Base(name)
+	name = unsafeFilenameCharacters.ReplaceAllString(name, "_")
+	name = strings.TrimLeft(strings.TrimSpace(name), ".")
+
+	if name == "" || name == partialDownloadName || name == validatorsName || name == lockName {
+		return ""
+	}
+
+	return name
+}
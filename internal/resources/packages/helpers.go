@@ -2,24 +2,49 @@
 package packages
 
 import (
+	"context"
 	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"os"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// reconciliationModeFail stops a create when a package with the same name already exists.
+	reconciliationModeFail = "fail"
+	// reconciliationModeAdopt takes an existing package with the same name under management on create.
+	reconciliationModeAdopt = "adopt"
 )
 
 // generateMD5FileHash accepts a file path and returns an MD5 hash of the file's contents.
 // It opens the file, creates a new MD5 hash object, copies the file content into the hash object, and computes the MD5 checksum of the file.
 func generateMD5FileHash(filePath string) (string, error) {
+	return generateFileHash(filePath, md5.New())
+}
+
+// generateSHA256FileHash accepts a file path and returns a SHA-256 hash of the file's contents.
+func generateSHA256FileHash(filePath string) (string, error) {
+	return generateFileHash(filePath, sha256.New())
+}
+
+// generateFileHash streams the contents of the file at filePath through hash and returns the hex encoded digest.
+func generateFileHash(filePath string, hash hash.Hash) (string, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
 		return "", fmt.Errorf("failed to open file %s: %v", filePath, err)
 	}
 	defer file.Close()
 
-	hash := md5.New()
-
 	if _, err := io.Copy(hash, file); err != nil {
 		return "", fmt.Errorf("failed to hash file contents of %s: %v", filePath, err)
 	}
@@ -30,3 +55,72 @@ func generateMD5FileHash(filePath string) (string, error) {
 
 	return hashString, nil
 }
+
+// generateFileHashes returns the MD5 and SHA-256 hashes of the package file, keyed by the state attribute holding them.
+func generateFileHashes(filePath string) (map[string]string, error) {
+	md5Hash, err := generateMD5FileHash(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate file hash for %s: %v", filePath, err)
+	}
+
+	sha256Hash, err := generateSHA256FileHash(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate file hash for %s: %v", filePath, err)
+	}
+
+	return map[string]string{
+		"md5_file_hash":    md5Hash,
+		"sha256_file_hash": sha256Hash,
+	}, nil
+}
+
+// verifyChecksum checks the contents of the file at filePath against a checksum in the form
+// "sha256:<hex digest>" or "sha512:<hex digest>".
+func verifyChecksum(filePath string, checksum string) error {
+	algorithm, expected, found := strings.Cut(checksum, ":")
+	if !found {
+		return fmt.Errorf("invalid checksum '%s', expected the form '<algorithm>:<hex digest>'", checksum)
+	}
+
+	var actual string
+	var err error
+	switch algorithm {
+	case "sha256":
+		actual, err = generateSHA256FileHash(filePath)
+	case "sha512":
+		actual, err = generateFileHash(filePath, sha512.New())
+	default:
+		return fmt.Errorf("unsupported checksum algorithm '%s', expected sha256 or sha512", algorithm)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s:%s, got %s:%s", filePath, algorithm, strings.ToLower(expected), algorithm, actual)
+	}
+
+	return nil
+}
+
+// findExistingPackage returns the package in Jamf Pro named packageName, or nil if there is none.
+// Packages are looked up through the package API only, so a script or other object sharing the name is never matched.
+func findExistingPackage(ctx context.Context, d *schema.ResourceData, client *jamfpro.Client, packageName string) (*jamfpro.ResourcePackage, error) {
+	var existing *jamfpro.ResourcePackage
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var apiErr error
+		existing, apiErr = getByName(client, packageName)
+		if common.IsNotFoundError(apiErr) {
+			existing = nil
+			return nil
+		}
+		return common.RetryOnError(ctx, apiErr)
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for an existing Jamf Pro Package named '%s' after retries: %v", packageName, err)
+	}
+
+	return existing, nil
+}
@@ -2,9 +2,11 @@
 package packages
 
 import (
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceJamfProPackages defines the schema and CRUD operations for managing Jamf Pro Packages in Terraform.
@@ -45,6 +47,32 @@ func ResourceJamfProPackages() *schema.Resource {
 				Required:    true,
 				Description: "The file path or the URL source of the Jamf Pro package to be uploaded. Supports HTTP/HTTPS URLs, and local filepaths.",
 			},
+			"download_cache_directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The directory caching files downloaded from a package_file_source URL, with one entry per URL and checksum. Defaults to a directory under the system temporary directory. Entries are locked while downloaded into, so packages sharing a URL download it once. A file cached with a `checksum` is reused without downloading it again, otherwise it is revalidated with the server.",
+			},
+			"download_cache_max_size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultDownloadCacheMaxSizeMB,
+				Description:  "The size in MiB above which the least recently used entries of the download cache are evicted after a download. Entries in use are never evicted. 0 means no limit.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"download_max_size_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The largest file in bytes which may be downloaded from a package_file_source URL. 0 means no limit.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"download_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "HTTP headers sent when downloading a package_file_source URL, for example an Authorization header for an artifact repository.",
+			},
 			"category_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -218,6 +246,24 @@ func ResourceJamfProPackages() *schema.Resource {
 				Computed:    true,
 				Description: "md5 hash of the package file for integrity comparison.",
 			},
+			"sha256_file_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the package file last uploaded.",
+			},
+			"checksum": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The expected checksum of the package file in the form `sha256:<hex digest>` or `sha512:<hex digest>`. The local or downloaded file is verified against it before upload and nothing is uploaded on a mismatch.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(sha256:[0-9a-fA-F]{64}|sha512:[0-9a-fA-F]{128})$`), "must be in the form sha256:<64 hex characters> or sha512:<128 hex characters>"),
+			},
+			"reconciliation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      reconciliationModeFail,
+				Description:  "What to do on create when a package with the same `package_name` already exists in Jamf Pro, for example one left behind by an interrupted apply. `fail` stops with an error naming the existing package, `adopt` takes it under management and updates it to match the configuration.",
+				ValidateFunc: validation.StringInSlice([]string{reconciliationModeFail, reconciliationModeAdopt}, false),
+			},
 		},
 	}
 }
@@ -0,0 +1,185 @@
+// patchpolicies_api.go
+package patchpolicies
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+const uriPatchPolicies = "/JSSResource/patchpolicies"
+
+/*
+resourcePatchPolicy is a patch policy of the Classic API.
+
+The SDK nests the kill apps and scope items of patch policies one element too deep and updates them through the
+create endpoint, so policies are sent and read through the HTTP client of the SDK with this model instead.
+*/
+type resourcePatchPolicy struct {
+	XMLName                      xml.Name                         `xml:"patch_policy"`
+	General                      patchPolicySubsetGeneral         `xml:"general"`
+	Scope                        patchPolicySubsetScope           `xml:"scope"`
+	UserInteraction              patchPolicySubsetUserInteraction `xml:"user_interaction"`
+	SoftwareTitleConfigurationID int                              `xml:"software_title_configuration_id"`
+}
+
+type patchPolicySubsetGeneral struct {
+	ID                 int                        `xml:"id,omitempty"`
+	Name               string                     `xml:"name"`
+	Enabled            bool                       `xml:"enabled"`
+	TargetVersion      string                     `xml:"target_version"`
+	ReleaseDate        string                     `xml:"release_date,omitempty"`
+	IncrementalUpdates bool                       `xml:"incremental_updates"`
+	Reboot             bool                       `xml:"reboot"`
+	MinimumOS          string                     `xml:"minimum_os,omitempty"`
+	KillApps           []patchPolicySubsetKillApp `xml:"kill_apps>kill_app"`
+	DistributionMethod string                     `xml:"distribution_method"`
+	AllowDowngrade     bool                       `xml:"allow_downgrade"`
+	PatchUnknown       bool                       `xml:"patch_unknown"`
+}
+
+type patchPolicySubsetKillApp struct {
+	KillAppName     string `xml:"kill_app_name"`
+	KillAppBundleID string `xml:"kill_app_bundle_id"`
+}
+
+type patchPolicySubsetScope struct {
+	AllComputers   bool                              `xml:"all_computers"`
+	Computers      []patchPolicySubsetScopeEntity    `xml:"computers>computer"`
+	ComputerGroups []patchPolicySubsetScopeEntity    `xml:"computer_groups>computer_group"`
+	Buildings      []patchPolicySubsetScopeEntity    `xml:"buildings>building"`
+	Departments    []patchPolicySubsetScopeEntity    `xml:"departments>department"`
+	Limitations    patchPolicySubsetScopeLimitations `xml:"limitations"`
+	Exclusions     patchPolicySubsetScopeExclusions  `xml:"exclusions"`
+}
+
+type patchPolicySubsetScopeLimitations struct {
+	NetworkSegments []patchPolicySubsetScopeEntity `xml:"network_segments>network_segment"`
+	IBeacons        []patchPolicySubsetScopeEntity `xml:"ibeacons>ibeacon"`
+}
+
+type patchPolicySubsetScopeExclusions struct {
+	Computers       []patchPolicySubsetScopeEntity `xml:"computers>computer"`
+	ComputerGroups  []patchPolicySubsetScopeEntity `xml:"computer_groups>computer_group"`
+	Buildings       []patchPolicySubsetScopeEntity `xml:"buildings>building"`
+	Departments     []patchPolicySubsetScopeEntity `xml:"departments>department"`
+	NetworkSegments []patchPolicySubsetScopeEntity `xml:"network_segments>network_segment"`
+	IBeacons        []patchPolicySubsetScopeEntity `xml:"ibeacons>ibeacon"`
+}
+
+type patchPolicySubsetScopeEntity struct {
+	ID   int    `xml:"id"`
+	Name string `xml:"name,omitempty"`
+}
+
+type patchPolicySubsetUserInteraction struct {
+	InstallButtonText      string                            `xml:"install_button_text,omitempty"`
+	SelfServiceDescription string                            `xml:"self_service_description,omitempty"`
+	SelfServiceIcon        *patchPolicySubsetSelfServiceIcon `xml:"self_service_icon,omitempty"`
+	Notifications          *patchPolicySubsetNotifications   `xml:"notifications,omitempty"`
+	Deadlines              *patchPolicySubsetDeadlines       `xml:"deadlines,omitempty"`
+	GracePeriod            *patchPolicySubsetGracePeriod     `xml:"grace_period,omitempty"`
+}
+
+type patchPolicySubsetSelfServiceIcon struct {
+	ID int `xml:"id"`
+}
+
+type patchPolicySubsetNotifications struct {
+	NotificationEnabled bool                       `xml:"notification_enabled"`
+	NotificationType    string                     `xml:"notification_type"`
+	NotificationSubject string                     `xml:"notification_subject"`
+	NotificationMessage string                     `xml:"notification_message"`
+	Reminders           patchPolicySubsetReminders `xml:"reminders"`
+}
+
+type patchPolicySubsetReminders struct {
+	NotificationRemindersEnabled  bool `xml:"notification_reminders_enabled"`
+	NotificationReminderFrequency int  `xml:"notification_reminder_frequency"`
+}
+
+type patchPolicySubsetDeadlines struct {
+	DeadlineEnabled bool `xml:"deadline_enabled"`
+	DeadlinePeriod  int  `xml:"deadline_period"`
+}
+
+type patchPolicySubsetGracePeriod struct {
+	GracePeriodDuration       int    `xml:"grace_period_duration"`
+	NotificationCenterSubject string `xml:"notification_center_subject"`
+	Message                   string `xml:"message"`
+}
+
+// responsePatchPoliciesList is the list of patch policies of the Classic API.
+type responsePatchPoliciesList struct {
+	Size          int                             `xml:"size"`
+	PatchPolicies []responsePatchPoliciesListItem `xml:"patch_policy"`
+}
+
+type responsePatchPoliciesListItem struct {
+	ID   int    `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// responsePatchPolicy is the response of the Classic API to a change of a patch policy.
+type responsePatchPolicy struct {
+	XMLName xml.Name `xml:"patch_policy"`
+	ID      int      `xml:"id"`
+}
+
+// api sends patch policy requests through the HTTP client of a Jamf Pro client.
+type api struct {
+	client *jamfpro.Client
+}
+
+// list fetches the ID and name of every patch policy from the Classic API, which the resource reads and writes
+// patch policies through, rather than the Jamf Pro API the SDK lists them with.
+func (a api) list() (*responsePatchPoliciesList, error) {
+	var list responsePatchPoliciesList
+	if err := a.do("GET", uriPatchPolicies, nil, &list); err != nil {
+		return nil, fmt.Errorf("failed to get patch policies, error: %v", err)
+	}
+
+	return &list, nil
+}
+
+// getByID fetches a patch policy by its ID.
+func (a api) getByID(id string) (*resourcePatchPolicy, error) {
+	var policy resourcePatchPolicy
+	if err := a.do("GET", fmt.Sprintf("%s/id/%s", uriPatchPolicies, id), nil, &policy); err != nil {
+		return nil, fmt.Errorf("failed to get patch policy by id: %s, error: %v", id, err)
+	}
+
+	return &policy, nil
+}
+
+// create creates a patch policy below its software title configuration, returning its ID.
+func (a api) create(policy *resourcePatchPolicy) (*responsePatchPolicy, error) {
+	var response responsePatchPolicy
+	endpoint := fmt.Sprintf("%s/softwaretitleconfig/id/%d", uriPatchPolicies, policy.SoftwareTitleConfigurationID)
+	if err := a.do("POST", endpoint, policy, &response); err != nil {
+		return nil, fmt.Errorf("failed to create patch policy, error: %v", err)
+	}
+
+	return &response, nil
+}
+
+// updateByID replaces a patch policy by its ID.
+func (a api) updateByID(id string, policy *resourcePatchPolicy) (*responsePatchPolicy, error) {
+	var response responsePatchPolicy
+	if err := a.do("PUT", fmt.Sprintf("%s/id/%s", uriPatchPolicies, id), policy, &response); err != nil {
+		return nil, fmt.Errorf("failed to update patch policy by id: %s, error: %v", id, err)
+	}
+
+	return &response, nil
+}
+
+// do sends a request and closes the body of its response.
+func (a api) do(method, endpoint string, body, out interface{}) error {
+	resp, err := a.client.HTTP.DoRequest(method, endpoint, body, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,132 @@
+// patchpolicies_object.go
+package patchpolicies
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a patch policy object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*resourcePatchPolicy, error) {
+	softwareTitleConfigurationID, err := strconv.Atoi(d.Get("software_title_configuration_id").(string))
+	if err != nil {
+		return nil, fmt.Errorf("software_title_configuration_id must be numeric, got '%s'", d.Get("software_title_configuration_id"))
+	}
+
+	resource := &resourcePatchPolicy{
+		General: patchPolicySubsetGeneral{
+			Name:               d.Get("name").(string),
+			Enabled:            d.Get("enabled").(bool),
+			TargetVersion:      d.Get("target_version").(string),
+			IncrementalUpdates: d.Get("incremental_updates").(bool),
+			Reboot:             d.Get("reboot").(bool),
+			DistributionMethod: d.Get("distribution_method").(string),
+			AllowDowngrade:     d.Get("allow_downgrade").(bool),
+			PatchUnknown:       d.Get("patch_unknown").(bool),
+		},
+		SoftwareTitleConfigurationID: softwareTitleConfigurationID,
+	}
+
+	for _, v := range d.Get("kill_app").([]interface{}) {
+		app := v.(map[string]interface{})
+		resource.General.KillApps = append(resource.General.KillApps, patchPolicySubsetKillApp{
+			KillAppName:     app["kill_app_name"].(string),
+			KillAppBundleID: app["kill_app_bundle_id"].(string),
+		})
+	}
+
+	resource.Scope = constructScope(d.Get("scope").([]interface{})[0].(map[string]interface{}))
+
+	if v, ok := d.GetOk("self_service"); ok {
+		constructSelfService(v.([]interface{})[0].(map[string]interface{}), &resource.UserInteraction)
+	}
+
+	if v, ok := d.GetOk("grace_period"); ok {
+		gracePeriod := v.([]interface{})[0].(map[string]interface{})
+		resource.UserInteraction.GracePeriod = &patchPolicySubsetGracePeriod{
+			GracePeriodDuration:       gracePeriod["duration"].(int),
+			NotificationCenterSubject: gracePeriod["notification_center_subject"].(string),
+			Message:                   gracePeriod["message"].(string),
+		}
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Patch Policy '%s' to XML: %v", resource.General.Name, err)
+	}
+
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Patch Policy XML:\n%s", string(resourceXML))
+
+	return resource, nil
+}
+
+// constructScope builds the scope of a patch policy from the computer settings of the shared scope schema.
+func constructScope(data map[string]interface{}) patchPolicySubsetScope {
+	scope := patchPolicySubsetScope{
+		AllComputers:   data["all_computers"].(bool),
+		Computers:      constructScopeEntities(data["computer_ids"]),
+		ComputerGroups: constructScopeEntities(data["computer_group_ids"]),
+		Buildings:      constructScopeEntities(data["building_ids"]),
+		Departments:    constructScopeEntities(data["department_ids"]),
+	}
+
+	if limitations, ok := data["limitations"].([]interface{}); ok && len(limitations) > 0 && limitations[0] != nil {
+		limitation := limitations[0].(map[string]interface{})
+		scope.Limitations.NetworkSegments = constructScopeEntities(limitation["network_segment_ids"])
+		scope.Limitations.IBeacons = constructScopeEntities(limitation["ibeacon_ids"])
+	}
+
+	if exclusions, ok := data["exclusions"].([]interface{}); ok && len(exclusions) > 0 && exclusions[0] != nil {
+		exclusion := exclusions[0].(map[string]interface{})
+		scope.Exclusions.Computers = constructScopeEntities(exclusion["computer_ids"])
+		scope.Exclusions.ComputerGroups = constructScopeEntities(exclusion["computer_group_ids"])
+		scope.Exclusions.Buildings = constructScopeEntities(exclusion["building_ids"])
+		scope.Exclusions.Departments = constructScopeEntities(exclusion["department_ids"])
+		scope.Exclusions.NetworkSegments = constructScopeEntities(exclusion["network_segment_ids"])
+		scope.Exclusions.IBeacons = constructScopeEntities(exclusion["ibeacon_ids"])
+	}
+
+	return scope
+}
+
+// constructScopeEntities builds scope entities from a list of IDs of the schema.
+func constructScopeEntities(ids interface{}) []patchPolicySubsetScopeEntity {
+	list, _ := ids.([]interface{})
+
+	var entities []patchPolicySubsetScopeEntity
+	for _, id := range list {
+		entities = append(entities, patchPolicySubsetScopeEntity{ID: id.(int)})
+	}
+	return entities
+}
+
+// constructSelfService sets the Self Service settings of the self_service block on the user interaction of a patch policy.
+func constructSelfService(data map[string]interface{}, userInteraction *patchPolicySubsetUserInteraction) {
+	userInteraction.InstallButtonText = data["install_button_text"].(string)
+	userInteraction.SelfServiceDescription = data["description"].(string)
+
+	if iconID := data["icon_id"].(int); iconID != 0 {
+		userInteraction.SelfServiceIcon = &patchPolicySubsetSelfServiceIcon{ID: iconID}
+	}
+
+	userInteraction.Notifications = &patchPolicySubsetNotifications{
+		NotificationEnabled: data["notification_enabled"].(bool),
+		NotificationType:    data["notification_type"].(string),
+		NotificationSubject: data["notification_subject"].(string),
+		NotificationMessage: data["notification_message"].(string),
+		Reminders: patchPolicySubsetReminders{
+			NotificationRemindersEnabled:  data["reminders_enabled"].(bool),
+			NotificationReminderFrequency: data["reminder_frequency"].(int),
+		},
+	}
+
+	userInteraction.Deadlines = &patchPolicySubsetDeadlines{
+		DeadlineEnabled: data["deadline_enabled"].(bool),
+		DeadlinePeriod:  data["deadline_period"].(int),
+	}
+}
@@ -0,0 +1,66 @@
+package patchpolicies
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating a new Jamf Pro Patch Policy in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return common.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		api{meta.(*jamfpro.Client)}.create,
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Patch Policy from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	return common.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		api{meta.(*jamfpro.Client)}.getByID,
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Patch Policy on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return common.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		api{meta.(*jamfpro.Client)}.updateByID,
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Patch Policy.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return common.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeletePatchPolicyByID,
+	)
+}
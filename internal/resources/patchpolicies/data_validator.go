@@ -0,0 +1,66 @@
+// patchpolicies_data_validator.go
+package patchpolicies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// unsupportedScopeSettings are the settings of the shared computer scope schema which target users. Patch policies
+// are scoped to computers only, and Jamf Pro drops these settings without an error.
+var unsupportedScopeSettings = []string{
+	"scope.0.all_jss_users",
+	"scope.0.jss_user_ids",
+	"scope.0.jss_user_group_ids",
+	"scope.0.limitations.0.directory_service_or_local_usernames",
+	"scope.0.limitations.0.directory_service_usergroup_ids",
+	"scope.0.exclusions.0.jss_user_ids",
+	"scope.0.exclusions.0.jss_user_group_ids",
+	"scope.0.exclusions.0.directory_service_or_local_usernames",
+	"scope.0.exclusions.0.directory_service_usergroup_ids",
+}
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if err := validateDistributionMethod(ctx, diff, i); err != nil {
+		return err
+	}
+
+	if err := validateScope(ctx, diff, i); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDistributionMethod checks that the 'self_service' block is used if and only if 'distribution_method' is "selfservice".
+func validateDistributionMethod(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	resourceName := diff.Get("name").(string)
+	distributionMethod := diff.Get("distribution_method").(string)
+	selfServiceBlockExists := len(diff.Get("self_service").([]interface{})) > 0
+
+	if distributionMethod == distributionMethodSelfService && !selfServiceBlockExists {
+		return fmt.Errorf("in 'jamfpro_patch_policy.%s': 'self_service' block is required when 'distribution_method' is set to '%s'", resourceName, distributionMethod)
+	}
+
+	if distributionMethod != distributionMethodSelfService && selfServiceBlockExists {
+		return fmt.Errorf("in 'jamfpro_patch_policy.%s': 'self_service' block is not allowed when 'distribution_method' is set to '%s'", resourceName, distributionMethod)
+	}
+
+	return nil
+}
+
+// validateScope checks that the scope only uses settings which patch policies support.
+func validateScope(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	resourceName := diff.Get("name").(string)
+
+	for _, setting := range unsupportedScopeSettings {
+		if _, ok := diff.GetOk(setting); ok {
+			return fmt.Errorf("in 'jamfpro_patch_policy.%s': '%s' cannot be set, as patch policies cannot be scoped to users", resourceName, setting)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+// patchpolicies_resource.go
+package patchpolicies
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Distribution methods of patch policies, as named by the Classic API.
+const (
+	distributionMethodPrompt      = "prompt"
+	distributionMethodSelfService = "selfservice"
+)
+
+// ResourceJamfProPatchPolicies defines the schema for managing Jamf Pro Patch Policies in Terraform. A patch policy
+// updates the computers in its scope to a version of the software title of its patch software title configuration.
+func ResourceJamfProPatchPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the patch policy.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the patch policy.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Define whether the patch policy is enabled.",
+			},
+			"software_title_configuration_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the patch software title configuration the policy patches.",
+			},
+			"target_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The version of the software title computers are updated to. The software title configuration must define a package for it.",
+			},
+			"release_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The release date of the target version.",
+			},
+			"minimum_os": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The minimum operating system version the target version requires.",
+			},
+			"distribution_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      distributionMethodPrompt,
+				ValidateFunc: validation.StringInSlice([]string{distributionMethodPrompt, distributionMethodSelfService}, false),
+				Description:  "How the update is distributed: `prompt` installs it automatically, `selfservice` makes it available in Self Service.",
+			},
+			"incremental_updates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if computers are updated through each intermediate version in turn.",
+			},
+			"reboot": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if computers restart after the update.",
+			},
+			"allow_downgrade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if computers with a later version than the target version are downgraded.",
+			},
+			"patch_unknown": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if computers on which the installed version is unknown are updated.",
+			},
+			"kill_app": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The apps quit before the update is installed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kill_app_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the app, e.g. `Google Chrome.app`.",
+						},
+						"kill_app_bundle_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The bundle ID of the app, e.g. `com.google.Chrome`.",
+						},
+					},
+				},
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The scope of the patch policy. Patch policies cannot be scoped to or limited by users, so only the computer, group, building, department, network segment and iBeacon settings may be used.",
+				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+			},
+			"self_service": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The Self Service settings of the patch policy. Required when `distribution_method` is `selfservice`, and not allowed otherwise.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"install_button_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "Update",
+							Description: "Text displayed on the install button in Self Service.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the update displayed in Self Service.",
+						},
+						"icon_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The ID of the icon displayed in Self Service.",
+						},
+						"notification_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates if users are notified that the update is available.",
+						},
+						"notification_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "Self Service",
+							Description: "Where users are notified, e.g. `Self Service`.",
+						},
+						"notification_subject": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The subject of the notification.",
+						},
+						"notification_message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The message of the notification.",
+						},
+						"reminders_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates if users are reminded of the update until it is installed.",
+						},
+						"reminder_frequency": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "The number of days between reminders.",
+						},
+						"deadline_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates if the update is installed automatically once the deadline passes.",
+						},
+						"deadline_period": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     7,
+							Description: "The number of days after which the update is installed automatically.",
+						},
+					},
+				},
+			},
+			"grace_period": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The grace period given to users to quit the apps of `kill_app` before the update is installed. Jamf Pro applies its defaults if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The grace period in minutes.",
+						},
+						"notification_center_subject": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The subject of the notification displayed during the grace period.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The message of the notification displayed during the grace period.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
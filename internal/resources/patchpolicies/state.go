@@ -0,0 +1,143 @@
+// patchpolicies_state.go
+package patchpolicies
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Patch Policy information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *resourcePatchPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	killApps := make([]interface{}, 0, len(resp.General.KillApps))
+	for _, app := range resp.General.KillApps {
+		killApps = append(killApps, map[string]interface{}{
+			"kill_app_name":      app.KillAppName,
+			"kill_app_bundle_id": app.KillAppBundleID,
+		})
+	}
+
+	policyAttributes := map[string]interface{}{
+		"name":                            resp.General.Name,
+		"enabled":                         resp.General.Enabled,
+		"software_title_configuration_id": strconv.Itoa(resp.SoftwareTitleConfigurationID),
+		"target_version":                  resp.General.TargetVersion,
+		"release_date":                    resp.General.ReleaseDate,
+		"minimum_os":                      resp.General.MinimumOS,
+		"distribution_method":             resp.General.DistributionMethod,
+		"incremental_updates":             resp.General.IncrementalUpdates,
+		"reboot":                          resp.General.Reboot,
+		"allow_downgrade":                 resp.General.AllowDowngrade,
+		"patch_unknown":                   resp.General.PatchUnknown,
+		"kill_app":                        killApps,
+		"scope":                           []interface{}{stateScope(resp.Scope)},
+		"self_service":                    []interface{}{},
+		"grace_period":                    []interface{}{},
+	}
+
+	// Jamf Pro keeps the Self Service settings of a policy distributed automatically, which the schema does not allow.
+	if resp.General.DistributionMethod == distributionMethodSelfService {
+		policyAttributes["self_service"] = []interface{}{stateSelfService(resp.UserInteraction)}
+	}
+
+	if gracePeriod := resp.UserInteraction.GracePeriod; gracePeriod != nil {
+		policyAttributes["grace_period"] = []interface{}{map[string]interface{}{
+			"duration":                    gracePeriod.GracePeriodDuration,
+			"notification_center_subject": gracePeriod.NotificationCenterSubject,
+			"message":                     gracePeriod.Message,
+		}}
+	}
+
+	for key, val := range policyAttributes {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// stateScope converts the scope of a patch policy to the shared scope schema. Limitations and exclusions are only
+// set when they hold any entity.
+func stateScope(scope patchPolicySubsetScope) map[string]interface{} {
+	scopeData := map[string]interface{}{
+		"all_computers":      scope.AllComputers,
+		"computer_ids":       stateScopeEntities(scope.Computers),
+		"computer_group_ids": stateScopeEntities(scope.ComputerGroups),
+		"building_ids":       stateScopeEntities(scope.Buildings),
+		"department_ids":     stateScopeEntities(scope.Departments),
+	}
+
+	limitations := map[string]interface{}{
+		"network_segment_ids": stateScopeEntities(scope.Limitations.NetworkSegments),
+		"ibeacon_ids":         stateScopeEntities(scope.Limitations.IBeacons),
+	}
+	if hasEntities(limitations) {
+		scopeData["limitations"] = []interface{}{limitations}
+	}
+
+	exclusions := map[string]interface{}{
+		"computer_ids":        stateScopeEntities(scope.Exclusions.Computers),
+		"computer_group_ids":  stateScopeEntities(scope.Exclusions.ComputerGroups),
+		"building_ids":        stateScopeEntities(scope.Exclusions.Buildings),
+		"department_ids":      stateScopeEntities(scope.Exclusions.Departments),
+		"network_segment_ids": stateScopeEntities(scope.Exclusions.NetworkSegments),
+		"ibeacon_ids":         stateScopeEntities(scope.Exclusions.IBeacons),
+	}
+	if hasEntities(exclusions) {
+		scopeData["exclusions"] = []interface{}{exclusions}
+	}
+
+	return scopeData
+}
+
+// stateScopeEntities returns the IDs of scope entities.
+func stateScopeEntities(entities []patchPolicySubsetScopeEntity) []int {
+	ids := make([]int, 0, len(entities))
+	for _, entity := range entities {
+		ids = append(ids, entity.ID)
+	}
+	return ids
+}
+
+// hasEntities reports whether any list of IDs of a scope block is non-empty.
+func hasEntities(block map[string]interface{}) bool {
+	for _, ids := range block {
+		if len(ids.([]int)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// stateSelfService converts the user interaction of a patch policy to the self_service block.
+func stateSelfService(userInteraction patchPolicySubsetUserInteraction) map[string]interface{} {
+	selfService := map[string]interface{}{
+		"install_button_text": userInteraction.InstallButtonText,
+		"description":         userInteraction.SelfServiceDescription,
+		"icon_id":             0,
+	}
+
+	if icon := userInteraction.SelfServiceIcon; icon != nil {
+		selfService["icon_id"] = icon.ID
+	}
+
+	if notifications := userInteraction.Notifications; notifications != nil {
+		selfService["notification_enabled"] = notifications.NotificationEnabled
+		selfService["notification_type"] = notifications.NotificationType
+		selfService["notification_subject"] = notifications.NotificationSubject
+		selfService["notification_message"] = notifications.NotificationMessage
+		selfService["reminders_enabled"] = notifications.Reminders.NotificationRemindersEnabled
+		selfService["reminder_frequency"] = notifications.Reminders.NotificationReminderFrequency
+	}
+
+	if deadlines := userInteraction.Deadlines; deadlines != nil {
+		selfService["deadline_enabled"] = deadlines.DeadlineEnabled
+		selfService["deadline_period"] = deadlines.DeadlinePeriod
+	}
+
+	return selfService
+}
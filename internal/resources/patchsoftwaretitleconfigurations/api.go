@@ -0,0 +1,114 @@
+// patchsoftwaretitleconfigurations_api.go
+package patchsoftwaretitleconfigurations
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+const uriPatchSoftwareTitleConfigurations = "/api/v2/patch-software-title-configurations"
+
+/*
+resourcePatchSoftwareTitleConfiguration is a patch software title configuration of the Jamf Pro API.
+
+The SDK builds its URLs with a doubled slash before the ID and does not read the patch source of a title, so
+configurations are sent and read through the HTTP client of the SDK with this model instead.
+*/
+type resourcePatchSoftwareTitleConfiguration struct {
+	ID                     string                                                    `json:"id,omitempty"`
+	DisplayName            string                                                    `json:"displayName"`
+	CategoryID             string                                                    `json:"categoryId"`
+	SiteID                 string                                                    `json:"siteId"`
+	UINotifications        bool                                                      `json:"uiNotifications"`
+	EmailNotifications     bool                                                      `json:"emailNotifications"`
+	SoftwareTitleID        string                                                    `json:"softwareTitleId"`
+	ExtensionAttributes    []patchSoftwareTitleConfigurationSubsetExtensionAttribute `json:"extensionAttributes"`
+	Packages               []patchSoftwareTitleConfigurationSubsetPackage            `json:"packages"`
+	SoftwareTitleName      string                                                    `json:"softwareTitleName,omitempty"`
+	SoftwareTitleNameID    string                                                    `json:"softwareTitleNameId,omitempty"`
+	SoftwareTitlePublisher string                                                    `json:"softwareTitlePublisher,omitempty"`
+	PatchSourceName        string                                                    `json:"patchSourceName,omitempty"`
+	PatchSourceEnabled     bool                                                      `json:"patchSourceEnabled,omitempty"`
+	JamfOfficial           bool                                                      `json:"jamfOfficial,omitempty"`
+}
+
+// patchSoftwareTitleConfigurationSubsetExtensionAttribute records whether an extension attribute the title relies
+// on has been accepted.
+type patchSoftwareTitleConfigurationSubsetExtensionAttribute struct {
+	Accepted bool   `json:"accepted"`
+	EaID     string `json:"eaId"`
+}
+
+// patchSoftwareTitleConfigurationSubsetPackage is the package installing a version of the title.
+type patchSoftwareTitleConfigurationSubsetPackage struct {
+	PackageID   string `json:"packageId"`
+	Version     string `json:"version"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// api sends patch software title configuration requests through the HTTP client of a Jamf Pro client.
+type api struct {
+	client *jamfpro.Client
+}
+
+// list fetches every patch software title configuration. Jamf Pro returns them as a single array rather than the
+// page of results the SDK expects.
+func (a api) list() ([]resourcePatchSoftwareTitleConfiguration, error) {
+	var configurations []resourcePatchSoftwareTitleConfiguration
+	if err := a.do("GET", uriPatchSoftwareTitleConfigurations, nil, &configurations); err != nil {
+		return nil, fmt.Errorf("failed to get patch software title configurations, error: %v", err)
+	}
+
+	return configurations, nil
+}
+
+// getByID fetches a patch software title configuration by its ID.
+func (a api) getByID(id string) (*resourcePatchSoftwareTitleConfiguration, error) {
+	var configuration resourcePatchSoftwareTitleConfiguration
+	if err := a.do("GET", fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id), nil, &configuration); err != nil {
+		return nil, fmt.Errorf("failed to get patch software title configuration by id: %s, error: %v", id, err)
+	}
+
+	return &configuration, nil
+}
+
+// create creates a patch software title configuration, returning its ID.
+func (a api) create(configuration *resourcePatchSoftwareTitleConfiguration) (*jamfpro.ResponsePatchSoftwareTitleConfigurationCreate, error) {
+	var response jamfpro.ResponsePatchSoftwareTitleConfigurationCreate
+	if err := a.do("POST", uriPatchSoftwareTitleConfigurations, configuration, &response); err != nil {
+		return nil, fmt.Errorf("failed to create patch software title configuration, error: %v", err)
+	}
+
+	return &response, nil
+}
+
+// updateByID updates a patch software title configuration by its ID. Jamf Pro merges the update into the stored
+// configuration, replacing its lists of extension attributes and packages as a whole.
+func (a api) updateByID(id string, configuration *resourcePatchSoftwareTitleConfiguration) (*resourcePatchSoftwareTitleConfiguration, error) {
+	var response resourcePatchSoftwareTitleConfiguration
+	if err := a.do("PATCH", fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id), configuration, &response); err != nil {
+		return nil, fmt.Errorf("failed to update patch software title configuration by id: %s, error: %v", id, err)
+	}
+
+	return &response, nil
+}
+
+// deleteByID deletes a patch software title configuration by its ID.
+func (a api) deleteByID(id string) error {
+	if err := a.do("DELETE", fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete patch software title configuration by id: %s, error: %v", id, err)
+	}
+
+	return nil
+}
+
+// do sends a request and closes the body of its response.
+func (a api) do(method, endpoint string, body, out interface{}) error {
+	resp, err := a.client.HTTP.DoRequest(method, endpoint, body, out)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return err
+}
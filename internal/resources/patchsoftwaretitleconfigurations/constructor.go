@@ -0,0 +1,57 @@
+// patchsoftwaretitleconfigurations_object.go
+package patchsoftwaretitleconfigurations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a patch software title configuration object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*resourcePatchSoftwareTitleConfiguration, error) {
+	resource := &resourcePatchSoftwareTitleConfiguration{
+		DisplayName:         d.Get("display_name").(string),
+		SoftwareTitleID:     d.Get("software_title_id").(string),
+		CategoryID:          d.Get("category_id").(string),
+		SiteID:              d.Get("site_id").(string),
+		UINotifications:     d.Get("ui_notifications").(bool),
+		EmailNotifications:  d.Get("email_notifications").(bool),
+		ExtensionAttributes: []patchSoftwareTitleConfigurationSubsetExtensionAttribute{},
+		Packages:            []patchSoftwareTitleConfigurationSubsetPackage{},
+	}
+
+	for _, v := range d.Get("extension_attribute").([]interface{}) {
+		attribute := v.(map[string]interface{})
+		resource.ExtensionAttributes = append(resource.ExtensionAttributes, patchSoftwareTitleConfigurationSubsetExtensionAttribute{
+			EaID:     attribute["ea_id"].(string),
+			Accepted: attribute["accepted"].(bool),
+		})
+	}
+
+	versions := make(map[string]bool)
+	for _, v := range d.Get("package").([]interface{}) {
+		pkg := v.(map[string]interface{})
+		version := pkg["version"].(string)
+		if versions[version] {
+			return nil, fmt.Errorf("version '%s' of patch software title configuration '%s' is assigned more than one package", version, resource.DisplayName)
+		}
+		versions[version] = true
+
+		resource.Packages = append(resource.Packages, patchSoftwareTitleConfigurationSubsetPackage{
+			PackageID: pkg["package_id"].(string),
+			Version:   version,
+		})
+	}
+
+	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Patch Software Title Configuration '%s' to JSON: %v", resource.DisplayName, err)
+	}
+
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Patch Software Title Configuration JSON:\n%s", string(resourceJSON))
+
+	return resource, nil
+}
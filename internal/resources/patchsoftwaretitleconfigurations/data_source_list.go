@@ -0,0 +1,103 @@
+// patchsoftwaretitleconfigurations_data_source_list.go
+package patchsoftwaretitleconfigurations
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the patch software title configurations list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "patch software title configuration",
+	Category:         true,
+	Site:             true,
+	Attributes: map[string]*schema.Schema{
+		"software_title_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the software title of the patch source the configuration is for.",
+		},
+		"software_title_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the software title the configuration is for.",
+		},
+	},
+}
+
+// DataSourceJamfProPatchSoftwareTitleConfigurationsList provides the patch software title configurations in
+// Jamf Pro, optionally filtered by name, category or site.
+func DataSourceJamfProPatchSoftwareTitleConfigurationsList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the patch software title configurations in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		nil,
+	)
+}
+
+// listItems returns all patch software title configurations in Jamf Pro as list items. Configurations only
+// reference their category and site by ID, so their names are resolved from a single list of each.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	categories, err := client.GetCategories("")
+	if err != nil {
+		return nil, err
+	}
+
+	categoryNames := make(map[string]string, len(categories.Results))
+	for _, category := range categories.Results {
+		categoryNames[category.Id] = category.Name
+	}
+
+	sites, err := client.GetSites()
+	if err != nil {
+		return nil, err
+	}
+
+	siteNames := make(map[string]string, len(sites.Site))
+	for _, site := range sites.Site {
+		siteNames[strconv.Itoa(site.ID)] = site.Name
+	}
+
+	configurations, err := api{client: client}.list()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(configurations))
+	for _, item := range configurations {
+		items = append(items, common.ListItem{
+			ID:       item.ID,
+			Name:     item.DisplayName,
+			Category: categoryNames[item.CategoryID],
+			Site:     siteNames[item.SiteID],
+			Attributes: map[string]interface{}{
+				"software_title_id":   item.SoftwareTitleID,
+				"software_title_name": item.SoftwareTitleName,
+			},
+		})
+	}
+
+	return items, nil
+}
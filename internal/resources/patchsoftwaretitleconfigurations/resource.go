@@ -0,0 +1,144 @@
+// patchsoftwaretitleconfigurations_resource.go
+package patchsoftwaretitleconfigurations
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProPatchSoftwareTitleConfiguration defines the schema for managing Jamf Pro Patch Software Title
+// Configurations in Terraform. A configuration adds a software title of a patch source to Patch Management, and
+// defines the package installing each version of the title which patch policies can target.
+func ResourceJamfProPatchSoftwareTitleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the patch software title configuration.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the patch software title configuration.",
+			},
+			"software_title_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the software title in its patch source, as listed by the available titles of the source. The title of a configuration cannot be changed once it is created.",
+			},
+			"category_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The ID of the category of the title. Defaults to -1, no category.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The ID of the site of the title. Defaults to -1, no site.",
+			},
+			"ui_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if Jamf Pro shows a notification in its web interface when a new version of the title is released.",
+			},
+			"email_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if Jamf Pro sends an email notification when a new version of the title is released.",
+			},
+			"extension_attribute": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The extension attributes the title relies on to report the installed version, which must be accepted before Jamf Pro collects them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ea_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The key of the extension attribute in the patch source, e.g. `google-chrome-ea`.",
+						},
+						"accepted": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Indicates if the extension attribute is accepted.",
+						},
+					},
+				},
+			},
+			"package": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The packages installing versions of the title, at most one per version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"package_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the package.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The version of the title the package installs, as named by the patch source.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the package.",
+						},
+					},
+				},
+			},
+			"software_title_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the software title in its patch source.",
+			},
+			"software_title_name_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name ID of the software title in its patch source, e.g. `GoogleChrome`.",
+			},
+			"software_title_publisher": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The publisher of the software title.",
+			},
+			"patch_source_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the patch source the software title comes from.",
+			},
+			"patch_source_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the patch source of the software title is enabled.",
+			},
+			"jamf_official": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the software title comes from the patch source maintained by Jamf.",
+			},
+		},
+	}
+}
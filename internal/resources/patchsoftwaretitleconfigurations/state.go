@@ -0,0 +1,54 @@
+// patchsoftwaretitleconfigurations_state.go
+package patchsoftwaretitleconfigurations
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Patch Software Title Configuration information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *resourcePatchSoftwareTitleConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	extensionAttributes := make([]interface{}, 0, len(resp.ExtensionAttributes))
+	for _, attribute := range resp.ExtensionAttributes {
+		extensionAttributes = append(extensionAttributes, map[string]interface{}{
+			"ea_id":    attribute.EaID,
+			"accepted": attribute.Accepted,
+		})
+	}
+
+	packages := make([]interface{}, 0, len(resp.Packages))
+	for _, pkg := range resp.Packages {
+		packages = append(packages, map[string]interface{}{
+			"package_id":   pkg.PackageID,
+			"version":      pkg.Version,
+			"display_name": pkg.DisplayName,
+		})
+	}
+
+	configurationAttributes := map[string]interface{}{
+		"display_name":             resp.DisplayName,
+		"software_title_id":        resp.SoftwareTitleID,
+		"category_id":              resp.CategoryID,
+		"site_id":                  resp.SiteID,
+		"ui_notifications":         resp.UINotifications,
+		"email_notifications":      resp.EmailNotifications,
+		"extension_attribute":      extensionAttributes,
+		"package":                  packages,
+		"software_title_name":      resp.SoftwareTitleName,
+		"software_title_name_id":   resp.SoftwareTitleNameID,
+		"software_title_publisher": resp.SoftwareTitlePublisher,
+		"patch_source_name":        resp.PatchSourceName,
+		"patch_source_enabled":     resp.PatchSourceEnabled,
+		"jamf_official":            resp.JamfOfficial,
+	}
+
+	for key, val := range configurationAttributes {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -1,17 +1,18 @@
 package policies
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructPolicy builds the policy object from the HCL. It's composed of several sub-objects, each with their own schema.
-func construct(d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
 	var err error
 	resource := &jamfpro.ResourcePolicy{}
 
@@ -24,11 +25,11 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
 
 	constructSelfService(d, resource)
 
-	constructPayloads(d, resource)
+	constructPayloads(ctx, d, resource)
 
 	// Debug
 	policyXML, _ := xml.MarshalIndent(resource, "", "  ")
-	log.Println(string(policyXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "%v", string(policyXML))
 
 	return resource, nil
 }
@@ -246,11 +247,11 @@ func constructScope(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) er
 		return err
 	}
 
-	// TODO make this better, it works for now
-	if resource.Scope.AllComputers && (resource.Scope.Computers != nil ||
-		resource.Scope.ComputerGroups != nil ||
-		resource.Scope.Departments != nil ||
-		resource.Scope.Buildings != nil) {
+	// The target lists are always allocated above, so test for entries rather than nil.
+	if resource.Scope.AllComputers && (len(*resource.Scope.Computers) > 0 ||
+		len(*resource.Scope.ComputerGroups) > 0 ||
+		len(*resource.Scope.Departments) > 0 ||
+		len(*resource.Scope.Buildings) > 0) {
 		return fmt.Errorf("invalid combination - all computers with scoped endpoints")
 	}
 
@@ -275,8 +276,8 @@ func constructSelfService(d *schema.ResourceData, out *jamfpro.ResourcePolicy) {
 }
 
 // constructPayloads builds the policy payload(s) from the HCL
-func constructPayloads(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) {
-	constructPayloadPackages(d, resource)
+func constructPayloads(ctx context.Context, d *schema.ResourceData, resource *jamfpro.ResourcePolicy) {
+	constructPayloadPackages(ctx, d, resource)
 	constructPayloadScripts(d, resource)
 	constructPayloadDiskEncryption(d, resource)
 	constructPayloadPrinters(d, resource)
@@ -289,13 +290,13 @@ func constructPayloads(d *schema.ResourceData, resource *jamfpro.ResourcePolicy)
 }
 
 // constructPayloadPackages builds the packages payload settings of the policy.
-func constructPayloadPackages(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) {
+func constructPayloadPackages(ctx context.Context, d *schema.ResourceData, resource *jamfpro.ResourcePolicy) {
 	hcl := d.Get("payloads.0.packages.0")
 	if len(hcl.(map[string]interface{})) == 0 {
 		return
 	}
-	log.Println("LOGHERE")
-	log.Println(hcl)
+	logging.Debugf(ctx, logging.SubsystemCRUD, "LOGHERE")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "%v", hcl)
 	var payload jamfpro.PolicySubsetPackageConfiguration
 	payload.DistributionPoint = hcl.(map[string]interface{})["distribution_point"].(string)
 	packageList := hcl.(map[string]interface{})["package"].([]interface{})
@@ -29,7 +29,9 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup
 		meta,
 		cleanup,
 		meta.(*jamfpro.Client).GetPolicyByID,
-		updateState,
+		func(d *schema.ResourceData, resp *jamfpro.ResourcePolicy) diag.Diagnostics {
+			return updateState(ctx, d, resp)
+		},
 	)
 }
 
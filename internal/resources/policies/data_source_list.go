@@ -0,0 +1,83 @@
+// policies_data_source_list.go
+package policies
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the policies list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "policy",
+	Category:         true,
+	Site:             true,
+	FilterOnly:       true,
+}
+
+// DataSourceJamfProPoliciesList provides the policies in Jamf Pro, optionally filtered by name, category or site.
+func DataSourceJamfProPoliciesList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the policies in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		func(item *common.ListItem) error {
+			return describeItem(client, item)
+		},
+	)
+}
+
+// listItems returns all policies in Jamf Pro as list items.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.Policy))
+	for _, item := range response.Policy {
+		items = append(items, common.ListItem{
+			ID:   strconv.Itoa(item.ID),
+			Name: item.Name,
+		})
+	}
+
+	return items, nil
+}
+
+// describeItem completes a policy list item with its category and site, which the list endpoint omits.
+func describeItem(client *jamfpro.Client, item *common.ListItem) error {
+	resource, err := client.GetPolicyByID(item.ID)
+	if err != nil {
+		return err
+	}
+
+	if resource.General.Category != nil {
+		item.Category = resource.General.Category.Name
+	}
+	if resource.General.Site != nil {
+		item.Site = resource.General.Site.Name
+	}
+
+	return nil
+}
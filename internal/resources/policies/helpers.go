@@ -1,10 +1,11 @@
 package policies
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"reflect"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -33,7 +34,7 @@ func GetAttrsListFromHCLForPointers[NestedObjectType any, ListItemPrimitiveType
 		if len(outList) > 0 {
 			*home = outList
 		} else {
-			log.Println("list is empty")
+			logging.Debugf(context.Background(), logging.SubsystemCRUD, "list is empty")
 		}
 		return nil
 	}
@@ -4,7 +4,9 @@ package policies
 // TODO maybe review error handling here too?
 
 import (
+	"context"
 	"reflect"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,10 +14,10 @@ import (
 )
 
 // Parent func for invdividual stating functions
-func updateState(d *schema.ResourceData, resp *jamfpro.ResourcePolicy) diag.Diagnostics {
+func updateState(ctx context.Context, d *schema.ResourceData, resp *jamfpro.ResourcePolicy) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	if err := d.Set("id", resp.General.ID); err != nil {
+	if err := d.Set("id", strconv.Itoa(resp.General.ID)); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -23,13 +25,13 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourcePolicy) diag.Diag
 	stateGeneral(d, resp, &diags)
 
 	// Scope
-	stateScope(d, resp, &diags)
+	stateScope(ctx, d, resp, &diags)
 
 	// Self Service
-	stateSelfService(d, resp, &diags)
+	stateSelfService(ctx, d, resp, &diags)
 
 	// Payloads
-	statePayloads(d, resp, &diags)
+	statePayloads(ctx, d, resp, &diags)
 
 	return diags
 }
@@ -93,7 +95,12 @@ func stateGeneral(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *d
 		*diags = append(*diags, diag.FromErr(err)...)
 	}
 
-	err = d.Set("target_drive", resp.General.OverrideDefaultSettings.TargetDrive)
+	// Jamf Pro reports the target drive under override_default_settings, which may be omitted.
+	targetDrive := resp.General.TargetDrive
+	if resp.General.OverrideDefaultSettings != nil {
+		targetDrive = resp.General.OverrideDefaultSettings.TargetDrive
+	}
+	err = d.Set("target_drive", targetDrive)
 	if err != nil {
 		*diags = append(*diags, diag.FromErr(err)...)
 	}
@@ -4,48 +4,49 @@ package policies
 // TODO maybe review error handling here too?
 
 import (
-	"log"
+	"context"
 	"reflect"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // Parent func for stating payloads. Constructs var with prep funcs and states as one here.
-func statePayloads(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *diag.Diagnostics) {
+func statePayloads(ctx context.Context, d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *diag.Diagnostics) {
 	out := make([]map[string]interface{}, 0)
 	out = append(out, make(map[string]interface{}, 1))
 
 	// DiskEncryption
-	prepStatePayloadDiskEncryption(&out, resp)
+	prepStatePayloadDiskEncryption(ctx, &out, resp)
 
 	// Packages
-	prepStatePayloadPackages(&out, resp)
+	prepStatePayloadPackages(ctx, &out, resp)
 
 	// Scripts
-	prepStatePayloadScripts(&out, resp)
+	prepStatePayloadScripts(ctx, &out, resp)
 
 	// Printers
-	prepStatePayloadPrinters(&out, resp)
+	prepStatePayloadPrinters(ctx, &out, resp)
 
 	// Dock Items
-	prepStatePayloadDockItems(&out, resp)
+	prepStatePayloadDockItems(ctx, &out, resp)
 
 	// Account Maintenance
-	prepStatePayloadAccountMaintenance(&out, resp)
+	prepStatePayloadAccountMaintenance(ctx, &out, resp)
 
 	// Files Processes
-	prepStatePayloadFilesProcesses(&out, resp)
+	prepStatePayloadFilesProcesses(ctx, &out, resp)
 
 	// User Interaction
-	prepStatePayloadUserInteraction(&out, resp)
+	prepStatePayloadUserInteraction(ctx, &out, resp)
 
 	// Reboot
-	prepStatePayloadReboot(&out, resp)
+	prepStatePayloadReboot(ctx, &out, resp)
 
 	// Maintenance
-	prepStatePayloadMaintenance(&out, resp)
+	prepStatePayloadMaintenance(ctx, &out, resp)
 
 	// State
 	err := d.Set("payloads", out)
@@ -55,9 +56,9 @@ func statePayloads(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *
 }
 
 // prepStatePayloadDiskEncryption reads response and preps disk encryption payload items for stating
-func prepStatePayloadDiskEncryption(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadDiskEncryption(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.DiskEncryption == nil {
-		log.Println("No disk encryption configuration found")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No disk encryption configuration found")
 		return
 	}
 
@@ -88,23 +89,23 @@ func prepStatePayloadDiskEncryption(out *[]map[string]interface{}, resp *jamfpro
 	}
 
 	if allDefault {
-		log.Println("All disk encryption values are default, skipping state")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "All disk encryption values are default, skipping state")
 		return
 	}
 
-	log.Println("Initializing disk encryption in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing disk encryption in state")
 	(*out)[0]["disk_encryption"] = []map[string]interface{}{diskEncryptionBlock}
-	log.Printf("Final state disk encryption: %+v\n", diskEncryptionBlock)
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state disk encryption: %+v", diskEncryptionBlock)
 }
 
 // Reads response and preps package payload items
-func prepStatePayloadPackages(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadPackages(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.PackageConfiguration == nil || resp.PackageConfiguration.Packages == nil {
-		log.Println("No package configuration found")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No package configuration found")
 		return
 	}
 
-	log.Println("Initializing packages in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing packages in state")
 
 	packagesMap := make(map[string]interface{})
 	packagesMap["distribution_point"] = resp.PackageConfiguration.DistributionPoint
@@ -120,17 +121,17 @@ func prepStatePayloadPackages(out *[]map[string]interface{}, resp *jamfpro.Resou
 	}
 
 	(*out)[0]["packages"] = []map[string]interface{}{packagesMap}
-	log.Printf("Final state packages: %+v\n", (*out)[0]["packages"])
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state packages: %+v", (*out)[0]["packages"])
 }
 
 // Reads response and preps script payload items
-func prepStatePayloadScripts(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadScripts(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.Scripts == nil {
-		log.Println("No scripts found")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No scripts found")
 		return
 	}
 
-	log.Println("Initializing scripts in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing scripts in state")
 	(*out)[0]["scripts"] = make([]map[string]interface{}, 0)
 
 	for _, v := range *resp.Scripts {
@@ -162,21 +163,21 @@ func prepStatePayloadScripts(out *[]map[string]interface{}, resp *jamfpro.Resour
 		if v.Parameter11 != "" {
 			outMap["parameter11"] = v.Parameter11
 		}
-		log.Printf("Adding script to state: %+v\n", outMap)
+		logging.Debugf(ctx, logging.SubsystemCRUD, "Adding script to state: %+v", outMap)
 		(*out)[0]["scripts"] = append((*out)[0]["scripts"].([]map[string]interface{}), outMap)
 	}
 
-	log.Printf("Final state scripts: %+v\n", (*out)[0]["scripts"])
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state scripts: %+v", (*out)[0]["scripts"])
 }
 
 // prepStatePayloadPrinters reads response and preps printer payload items for stating
-func prepStatePayloadPrinters(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
-	if resp.Printers.Printer == nil {
-		log.Println("No printers found")
+func prepStatePayloadPrinters(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+	if resp.Printers == nil || resp.Printers.Printer == nil {
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No printers found")
 		return
 	}
 
-	log.Println("Initializing printers in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing printers in state")
 	(*out)[0]["printers"] = make([]map[string]interface{}, 0)
 
 	for _, v := range *resp.Printers.Printer {
@@ -186,21 +187,21 @@ func prepStatePayloadPrinters(out *[]map[string]interface{}, resp *jamfpro.Resou
 		outMap["action"] = v.Action
 		outMap["make_default"] = v.MakeDefault
 
-		log.Printf("Adding printer to state: %+v\n", outMap)
+		logging.Debugf(ctx, logging.SubsystemCRUD, "Adding printer to state: %+v", outMap)
 		(*out)[0]["printers"] = append((*out)[0]["printers"].([]map[string]interface{}), outMap)
 	}
 
-	log.Printf("Final state printers: %+v\n", (*out)[0]["printers"])
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state printers: %+v", (*out)[0]["printers"])
 }
 
 // Reads response and preps dock items payload items
-func prepStatePayloadDockItems(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
-	if resp.DockItems.DockItem == nil {
-		log.Println("No dock items found")
+func prepStatePayloadDockItems(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+	if resp.DockItems == nil || resp.DockItems.DockItem == nil {
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No dock items found")
 		return
 	}
 
-	log.Println("Initializing dock items in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing dock items in state")
 	(*out)[0]["dock_items"] = make([]map[string]interface{}, 0)
 
 	for _, v := range *resp.DockItems.DockItem {
@@ -209,22 +210,22 @@ func prepStatePayloadDockItems(out *[]map[string]interface{}, resp *jamfpro.Reso
 		outMap["name"] = v.Name
 		outMap["action"] = v.Action
 
-		log.Printf("Adding dock item to state: %+v\n", outMap)
+		logging.Debugf(ctx, logging.SubsystemCRUD, "Adding dock item to state: %+v", outMap)
 		(*out)[0]["dock_items"] = append((*out)[0]["dock_items"].([]map[string]interface{}), outMap)
 	}
 
-	log.Printf("Final state dock items: %+v\n", (*out)[0]["dock_items"])
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state dock items: %+v", (*out)[0]["dock_items"])
 }
 
 // prepStatePayloadAccountMaintenance reads response and preps account maintenance payload items.
 // If all values are default, do not set the account_maintenance block
-func prepStatePayloadAccountMaintenance(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadAccountMaintenance(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.AccountMaintenance == nil {
-		log.Println("No account maintenance configuration found")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No account maintenance configuration found")
 		return
 	}
 
-	log.Println("Initializing account maintenance in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing account maintenance in state")
 	accountMaintenanceMap := make(map[string]interface{})
 
 	// Handle accounts
@@ -244,7 +245,7 @@ func prepStatePayloadAccountMaintenance(out *[]map[string]interface{}, resp *jam
 			accountMap["admin"] = v.Admin
 			accountMap["filevault_enabled"] = v.FilevaultEnabled
 
-			log.Printf("Adding account to state: %+v\n", accountMap)
+			logging.Debugf(ctx, logging.SubsystemCRUD, "Adding account to state: %+v", accountMap)
 			localAccounts = append(localAccounts, accountMap)
 		}
 
@@ -263,7 +264,7 @@ func prepStatePayloadAccountMaintenance(out *[]map[string]interface{}, resp *jam
 			bindingMap["id"] = v.ID
 			bindingMap["name"] = v.Name
 
-			log.Printf("Adding directory binding to state: %+v\n", bindingMap)
+			logging.Debugf(ctx, logging.SubsystemCRUD, "Adding directory binding to state: %+v", bindingMap)
 			directoryBindings = append(directoryBindings, bindingMap)
 		}
 
@@ -282,7 +283,7 @@ func prepStatePayloadAccountMaintenance(out *[]map[string]interface{}, resp *jam
 			managementAccountMap["managed_password"] = resp.AccountMaintenance.ManagementAccount.ManagedPassword
 			managementAccountMap["managed_password_length"] = resp.AccountMaintenance.ManagementAccount.ManagedPasswordLength
 
-			log.Printf("Adding management account to state: %+v\n", managementAccountMap)
+			logging.Debugf(ctx, logging.SubsystemCRUD, "Adding management account to state: %+v", managementAccountMap)
 			accountMaintenanceMap["management_account"] = []map[string]interface{}{managementAccountMap}
 		}
 	}
@@ -294,21 +295,21 @@ func prepStatePayloadAccountMaintenance(out *[]map[string]interface{}, resp *jam
 			openFirmwareMap["of_mode"] = resp.AccountMaintenance.OpenFirmwareEfiPassword.OfMode
 			openFirmwareMap["of_password"] = resp.AccountMaintenance.OpenFirmwareEfiPassword.OfPassword
 
-			log.Printf("Adding open firmware/EFI password to state: %+v\n", openFirmwareMap)
+			logging.Debugf(ctx, logging.SubsystemCRUD, "Adding open firmware/EFI password to state: %+v", openFirmwareMap)
 			accountMaintenanceMap["open_firmware_efi_password"] = []map[string]interface{}{openFirmwareMap}
 		}
 	}
 
 	if len(accountMaintenanceMap) > 0 {
 		(*out)[0]["account_maintenance"] = []map[string]interface{}{accountMaintenanceMap}
-		log.Printf("Final state account maintenance: %+v\n", (*out)[0]["account_maintenance"])
+		logging.Debugf(ctx, logging.SubsystemCRUD, "Final state account maintenance: %+v", (*out)[0]["account_maintenance"])
 	}
 }
 
 // prepStatePayloadFilesProcesses reads response and preps files and processes payload items.
-func prepStatePayloadFilesProcesses(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadFilesProcesses(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.FilesProcesses == nil {
-		log.Println("No files and processes configuration found")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No files and processes configuration found")
 		return
 	}
 
@@ -345,19 +346,19 @@ func prepStatePayloadFilesProcesses(out *[]map[string]interface{}, resp *jamfpro
 	}
 
 	if allDefault {
-		log.Println("All files and processes values are default, skipping state")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "All files and processes values are default, skipping state")
 		return
 	}
 
-	log.Println("Initializing files and processes in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing files and processes in state")
 	(*out)[0]["files_processes"] = []map[string]interface{}{filesProcessesBlock}
-	log.Printf("Final state files and processes: %+v\n", filesProcessesBlock)
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state files and processes: %+v", filesProcessesBlock)
 }
 
 // prepStatePayloadUserInteraction Reads response and preps user interaction payload items. If all values are default, do not set the user_interaction block
-func prepStatePayloadUserInteraction(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadUserInteraction(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.UserInteraction == nil {
-		log.Println("No user interaction configuration found")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No user interaction configuration found")
 		return
 	}
 
@@ -388,19 +389,19 @@ func prepStatePayloadUserInteraction(out *[]map[string]interface{}, resp *jamfpr
 	}
 
 	if allDefault {
-		log.Println("All user interaction values are default, skipping state")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "All user interaction values are default, skipping state")
 		return
 	}
 
-	log.Println("Initializing user interaction in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing user interaction in state")
 	(*out)[0]["user_interaction"] = []map[string]interface{}{userInteractionBlock}
-	log.Printf("Final state user interaction: %+v\n", userInteractionBlock)
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state user interaction: %+v", userInteractionBlock)
 }
 
 // Reads response and preps reboot payload items
-func prepStatePayloadReboot(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadReboot(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.Reboot == nil {
-		log.Println("No reboot configuration found")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No reboot configuration found")
 		return
 	}
 
@@ -415,13 +416,13 @@ func prepStatePayloadReboot(out *[]map[string]interface{}, resp *jamfpro.Resourc
 		"file_vault_2_reboot":            resp.Reboot.FileVault2Reboot,
 	}
 
-	log.Println("Initializing reboot in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing reboot in state")
 	(*out)[0]["reboot"] = []map[string]interface{}{rebootBlock}
-	log.Printf("Final state reboot: %+v\n", rebootBlock)
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Final state reboot: %+v", rebootBlock)
 }
 
 // prepStatePayloadMaintenance Reads response and preps maintenance payload items. If all values are default, do not set the maintenance block
-func prepStatePayloadMaintenance(out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
+func prepStatePayloadMaintenance(ctx context.Context, out *[]map[string]interface{}, resp *jamfpro.ResourcePolicy) {
 	if resp.Maintenance == nil {
 		return
 	}
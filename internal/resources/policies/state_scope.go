@@ -4,15 +4,16 @@ package policies
 // TODO maybe review error handling here too?
 
 import (
-	"log"
+	"context"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // Reads response and states scope items
-func stateScope(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *diag.Diagnostics) {
+func stateScope(ctx context.Context, d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *diag.Diagnostics) {
 	var err error
 
 	out_scope := make([]map[string]interface{}, 0)
@@ -214,7 +215,7 @@ func stateScope(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *dia
 	if exclusionsSet {
 		out_scope[0]["exclusions"] = out_scope_exclusions
 	} else {
-		log.Println("No exclusions set") // TODO logging
+		logging.Debugf(ctx, logging.SubsystemCRUD, "No exclusions set")
 	}
 
 	// State Scope
@@ -4,15 +4,16 @@ package policies
 // TODO maybe review error handling here too?
 
 import (
-	"log"
+	"context"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // stateSelfService Reads response and states self-service items and states only if non-default
-func stateSelfService(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *diag.Diagnostics) {
+func stateSelfService(ctx context.Context, d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *diag.Diagnostics) {
 	if resp.SelfService == nil {
 		return
 	}
@@ -44,11 +45,11 @@ func stateSelfService(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diag
 	}
 
 	if !nonDefault {
-		log.Println("[DEBUG] Self-service block has only default values, skipping state")
+		logging.Debugf(ctx, logging.SubsystemCRUD, "Self-service block has only default values, skipping state")
 		return
 	}
 
-	log.Println("[DEBUG] Initializing self-service block in state")
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Initializing self-service block in state")
 	out_ss := make([]map[string]interface{}, 0)
 	out_ss = append(out_ss, make(map[string]interface{}, 1))
 
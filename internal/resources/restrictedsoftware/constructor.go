@@ -2,17 +2,18 @@
 package restrictedsoftware
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProRestrictedSoftware constructs a RestrictedSoftware object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceRestrictedSoftware, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceRestrictedSoftware, error) {
 	resource := &jamfpro.ResourceRestrictedSoftware{
 		General: jamfpro.RestrictedSoftwareSubsetGeneral{
 			Name:                  d.Get("name").(string),
@@ -78,7 +79,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceRestrictedSoftware, err
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Restricted Software '%s' to XML: %v", resource.General.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Restricted Software XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Restricted Software XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
@@ -2,16 +2,17 @@
 package scripts
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProScript constructs a ResourceScript object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceScript, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceScript, error) {
 	resource := &jamfpro.ResourceScript{
 		Name:           d.Get("name").(string),
 		Info:           d.Get("info").(string),
@@ -38,7 +39,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceScript, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Script '%s' to JSON: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Script JSON:\n%s\n", string(resourceJSON))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Script JSON:\n%s", string(resourceJSON))
 
 	return resource, nil
 }
@@ -0,0 +1,62 @@
+// scripts_data_source_list.go
+package scripts
+
+import (
+	"context"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the scripts list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "script",
+	Category:         true,
+}
+
+// DataSourceJamfProScriptsList provides the scripts in Jamf Pro, optionally filtered by name or category.
+func DataSourceJamfProScriptsList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the scripts in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		nil,
+	)
+}
+
+// listItems returns all scripts in Jamf Pro as list items. The Jamf Pro API includes the category of each script.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetScripts("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.Results))
+	for _, item := range response.Results {
+		items = append(items, common.ListItem{
+			ID:       item.ID,
+			Name:     item.Name,
+			Category: item.CategoryName,
+		})
+	}
+
+	return items, nil
+}
@@ -0,0 +1,91 @@
+// serverinfo_data_source.go
+package serverinfo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfversion"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProServerInfo provides the version of the Jamf Pro server the provider is connected to.
+func DataSourceJamfProServerInfo() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version reported by Jamf Pro, including its build, e.g. `11.5.0-t1714996541`.",
+			},
+			"major_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The major version of Jamf Pro, e.g. `11`.",
+			},
+			"minor_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minor version of Jamf Pro, e.g. `5`.",
+			},
+			"patch_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The patch version of Jamf Pro, e.g. `0`.",
+			},
+			"build": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The build of the Jamf Pro release, e.g. `t1714996541`. Empty if Jamf Pro does not report one.",
+			},
+		},
+	}
+}
+
+// dataSourceRead returns the version of the connected Jamf Pro server, as read when the provider was configured.
+// It is only fetched again if the provider could not read it then.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	server, ok := jamfversion.ServerFromContext(ctx)
+	if !ok {
+		response, err := meta.(*jamfpro.Client).GetJamfProVersion()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read the Jamf Pro version: %v", err))
+		}
+		if response.Version == nil {
+			return diag.FromErr(fmt.Errorf("failed to read the Jamf Pro version: the response holds no version"))
+		}
+
+		version, err := jamfversion.Parse(*response.Version)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		server = jamfversion.Server{Version: version, Reported: *response.Version}
+	}
+	_, build, _ := strings.Cut(server.Reported, "-")
+
+	var diags diag.Diagnostics
+	d.SetId(server.Reported)
+
+	values := map[string]interface{}{
+		"version":       server.Reported,
+		"major_version": server.Version.Major,
+		"minor_version": server.Version.Minor,
+		"patch_version": server.Version.Patch,
+		"build":         build,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
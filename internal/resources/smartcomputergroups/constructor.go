@@ -2,17 +2,18 @@
 package smartcomputergroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProSmartComputerGroup constructs a ResourceComputerGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 	resource := &jamfpro.ResourceComputerGroup{
 		Name:    d.Get("name").(string),
 		IsSmart: true,
@@ -29,7 +30,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Group '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Group XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Computer Group XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
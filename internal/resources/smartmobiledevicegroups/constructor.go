@@ -0,0 +1,58 @@
+// smartmobiledevicegroup_object.go
+package smartmobiledevicegroups
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceMobileDeviceGroup object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceGroup, error) {
+	resource := &jamfpro.ResourceMobileDeviceGroup{
+		Name:    d.Get("name").(string),
+		IsSmart: true,
+		Site:    *sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int)),
+	}
+
+	if v, ok := d.GetOk("criteria"); ok {
+		resource.Criteria = constructMobileDeviceGroupContainerCriteria(v.([]interface{}))
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Group '%s' to XML: %v", resource.Name, err)
+	}
+
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Mobile Device Group XML:\n%s", string(resourceXML))
+
+	return resource, nil
+}
+
+// constructMobileDeviceGroupContainerCriteria constructs a SharedContainerCriteria object from the provided schema data.
+func constructMobileDeviceGroupContainerCriteria(criteriaList []interface{}) jamfpro.SharedContainerCriteria {
+	criteria := jamfpro.SharedContainerCriteria{
+		Size:      len(criteriaList),
+		Criterion: make([]jamfpro.SharedSubsetCriteria, 0, len(criteriaList)),
+	}
+
+	for _, item := range criteriaList {
+		criterionData := item.(map[string]interface{})
+		criteria.Criterion = append(criteria.Criterion, jamfpro.SharedSubsetCriteria{
+			Name:         criterionData["name"].(string),
+			Priority:     criterionData["priority"].(int),
+			AndOr:        criterionData["and_or"].(string),
+			SearchType:   criterionData["search_type"].(string),
+			Value:        criterionData["value"].(string),
+			OpeningParen: criterionData["opening_paren"].(bool),
+			ClosingParen: criterionData["closing_paren"].(bool),
+		})
+	}
+
+	return criteria
+}
@@ -0,0 +1,48 @@
+// smartmobiledevicegroup_data_validator.go
+package smartmobiledevicegroups
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/criteria"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	// Validate criteria priorities
+	if err := criteria.ValidatePriority(diff, "jamfpro_smart_mobile_device_group"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getCriteriaOperators returns a list of criteria operators for Smart Mobile Device Groups.
+func getCriteriaOperators() []string {
+	var out []string
+	out = []string{
+		And,
+		Or,
+		SearchTypeIs,
+		SearchTypeIsNot,
+		SearchTypeHas,
+		SearchTypeDoesNotHave,
+		SearchTypeMemberOf,
+		SearchTypeNotMemberOf,
+		SearchTypeBeforeYYYYMMDD,
+		SearchTypeAfterYYYYMMDD,
+		SearchTypeMoreThanXDaysAgo,
+		SearchTypeLessThanXDaysAgo,
+		SearchTypeLike,
+		SearchTypeNotLike,
+		SearchTypeGreaterThan,
+		SearchTypeMoreThan,
+		SearchTypeLessThan,
+		SearchTypeGreaterThanOrEqual,
+		SearchTypeLessThanOrEqual,
+		SearchTypeMatchesRegex,
+		SearchTypeDoesNotMatch,
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+// smartmobiledevicegroup_state.go
+package smartmobiledevicegroups
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the provided ResourceMobileDeviceGroup object.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := d.Set("name", resp.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("is_smart", resp.IsSmart); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("site_id", resp.Site.ID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("criteria", setMobileDeviceGroupContainerCriteria(resp.Criteria)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// setMobileDeviceGroupContainerCriteria flattens a SharedContainerCriteria object into a format suitable for Terraform state.
+func setMobileDeviceGroupContainerCriteria(criteria jamfpro.SharedContainerCriteria) []interface{} {
+	criteriaList := make([]interface{}, 0, len(criteria.Criterion))
+	for _, criterion := range criteria.Criterion {
+		criteriaList = append(criteriaList, map[string]interface{}{
+			"name":          criterion.Name,
+			"priority":      criterion.Priority,
+			"and_or":        criterion.AndOr,
+			"search_type":   criterion.SearchType,
+			"value":         criterion.Value,
+			"opening_paren": criterion.OpeningParen,
+			"closing_paren": criterion.ClosingParen,
+		})
+	}
+
+	return criteriaList
+}
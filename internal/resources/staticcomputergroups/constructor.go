@@ -2,17 +2,18 @@
 package staticcomputergroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProStaticComputerGroup constructs a ResourceComputerGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 	resource := &jamfpro.ResourceComputerGroup{
 		Name:    d.Get("name").(string),
 		IsSmart: false,
@@ -20,15 +21,15 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 
 	resource.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
 
-	if resource.Computers != nil {
-		assignedComputers := d.Get("assigned_computer_ids").([]interface{})
-		if len(assignedComputers) > 0 {
-			for _, v := range assignedComputers {
-				*resource.Computers = append(*resource.Computers, jamfpro.ComputerGroupSubsetComputer{
-					ID: v.(int),
-				})
-			}
+	assignedComputers := d.Get("assigned_computer_ids").([]interface{})
+	if len(assignedComputers) > 0 {
+		computers := make([]jamfpro.ComputerGroupSubsetComputer, 0, len(assignedComputers))
+		for _, v := range assignedComputers {
+			computers = append(computers, jamfpro.ComputerGroupSubsetComputer{
+				ID: v.(int),
+			})
 		}
+		resource.Computers = &computers
 	}
 
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
@@ -36,7 +37,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Group '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Group XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Computer Group XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
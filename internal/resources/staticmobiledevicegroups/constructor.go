@@ -0,0 +1,37 @@
+// staticmobiledevicegroup_object.go
+package staticmobiledevicegroups
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceMobileDeviceGroup object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceGroup, error) {
+	resource := &jamfpro.ResourceMobileDeviceGroup{
+		Name:    d.Get("name").(string),
+		IsSmart: false,
+		Site:    *sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int)),
+	}
+
+	for _, v := range d.Get("assigned_mobile_device_ids").([]interface{}) {
+		resource.MobileDevices = append(resource.MobileDevices, jamfpro.MobileDeviceGroupSubsetDeviceItem{
+			ID: v.(int),
+		})
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Group '%s' to XML: %v", resource.Name, err)
+	}
+
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Mobile Device Group XML:\n%s", string(resourceXML))
+
+	return resource, nil
+}
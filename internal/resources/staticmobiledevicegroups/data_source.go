@@ -0,0 +1,82 @@
+// staticmobiledevicegroup_data_source.go
+package staticmobiledevicegroups
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProStaticMobileDeviceGroups provides information about a specific static mobile device group in Jamf Pro.
+func DataSourceJamfProStaticMobileDeviceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique identifier of the static mobile device group. Conflicts with `name`.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The unique name of the static mobile device group. Conflicts with `id`.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+		},
+	}
+}
+
+// dataSourceRead fetches the details of a specific static mobile device group from Jamf Pro using either its unique name or its ID.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return common.DataSourceRead(
+		ctx,
+		d,
+		"Static Mobile Device Group",
+		"name",
+		client.GetMobileDeviceGroupByID,
+		client.GetMobileDeviceGroupByName,
+		func() ([]string, error) {
+			return listNames(client)
+		},
+		dataSourceUpdateState,
+	)
+}
+
+// listNames returns the names of all static mobile device groups in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]string, error) {
+	response, err := client.GetMobileDeviceGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(response.MobileDeviceGroup))
+	for _, item := range response.MobileDeviceGroup {
+		if !item.IsSmart {
+			names = append(names, item.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// dataSourceUpdateState updates the Terraform state with the static mobile device group returned by the data source lookup.
+func dataSourceUpdateState(d *schema.ResourceData, resource *jamfpro.ResourceMobileDeviceGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(strconv.Itoa(resource.ID))
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
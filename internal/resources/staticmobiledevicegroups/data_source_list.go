@@ -0,0 +1,80 @@
+// staticmobiledevicegroups_data_source_list.go
+package staticmobiledevicegroups
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listDataSource describes the static mobile device groups list data source.
+var listDataSource = common.ListDataSourceConfig{
+	ResourceTypeName: "static mobile device group",
+	Site:             true,
+	FilterOnly:       true,
+}
+
+// DataSourceJamfProStaticMobileDeviceGroupsList provides the static mobile device groups in Jamf Pro, optionally filtered by name or site.
+func DataSourceJamfProStaticMobileDeviceGroupsList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: listDataSource.Schema(),
+	}
+}
+
+// dataSourceListRead lists the static mobile device groups in Jamf Pro matching the configured filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	return listDataSource.Read(
+		ctx,
+		d,
+		func() ([]common.ListItem, error) {
+			return listItems(client)
+		},
+		func(item *common.ListItem) error {
+			return describeItem(client, item)
+		},
+	)
+}
+
+// listItems returns all static mobile device groups in Jamf Pro as list items.
+func listItems(client *jamfpro.Client) ([]common.ListItem, error) {
+	response, err := client.GetMobileDeviceGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]common.ListItem, 0, len(response.MobileDeviceGroup))
+	for _, item := range response.MobileDeviceGroup {
+		if item.IsSmart {
+			continue
+		}
+		items = append(items, common.ListItem{
+			ID:   strconv.Itoa(item.ID),
+			Name: item.Name,
+		})
+	}
+
+	return items, nil
+}
+
+// describeItem completes a static mobile device group list item with its site, which the list endpoint omits.
+func describeItem(client *jamfpro.Client, item *common.ListItem) error {
+	resource, err := client.GetMobileDeviceGroupByID(item.ID)
+	if err != nil {
+		return err
+	}
+
+	item.Site = resource.Site.Name
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// staticmobiledevicegroup_resource.go
+package staticmobiledevicegroups
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProStaticMobileDeviceGroups defines the schema and CRUD operations for managing Jamf Pro static Mobile Device Groups in Terraform.
+func ResourceJamfProStaticMobileDeviceGroups() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the Jamf Pro static mobile device group.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique name of the Jamf Pro static mobile device group.",
+			},
+			"is_smart": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Computed value indicating whether the mobile device group is smart or static.",
+			},
+			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"assigned_mobile_device_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The IDs of the mobile devices assigned to the group.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// tokeninvalidation_crud.go
+package tokeninvalidation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/tokencache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for adding the resource to the Terraform state. Nothing is created in Jamf Pro.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(time.Now().UTC().Format(time.RFC3339))
+
+	return diags
+}
+
+// read is responsible for keeping the resource in the Terraform state. There is nothing to read from Jamf Pro.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+// delete is responsible for invalidating the cached access token of the provider instance.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := tokencache.Invalidate(meta); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to invalidate the Jamf Pro access token: %v", err))...)
+	}
+
+	logging.Infof(ctx, logging.SubsystemCRUD, "Invalidated the Jamf Pro access token of the provider")
+	d.SetId("")
+
+	return diags
+}
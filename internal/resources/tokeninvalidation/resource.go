@@ -0,0 +1,31 @@
+// tokeninvalidation_resource.go
+package tokeninvalidation
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProTokenInvalidation defines a resource which invalidates the access token of its provider instance
+// when destroyed. The token is dropped from the token cache and revoked on Jamf Pro, so a cached token does not
+// outlive the configuration using it. Resources of the configuration should depend on it so it is destroyed last.
+func ResourceJamfProTokenInvalidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   read,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The RFC 3339 timestamp at which the resource was created.",
+			},
+		},
+	}
+}
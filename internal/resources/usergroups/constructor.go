@@ -2,17 +2,18 @@
 package usergroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProUserGroup constructs a ResourceUserGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
 	resource := &jamfpro.ResourceUserGroup{
 		Name:             d.Get("name").(string),
 		IsSmart:          d.Get("is_smart").(bool),
@@ -54,7 +55,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro User Group  '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro User Group  XML:\n%s\n", string(resourceXML))
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro User Group  XML:\n%s", string(resourceXML))
 
 	return resource, nil
 }
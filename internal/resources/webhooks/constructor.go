@@ -2,15 +2,17 @@
 package webhooks
 
 import (
+	"context"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProWebhook constructs a ResourceWebhook object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceWebhook, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceWebhook, error) {
 	resource := &jamfpro.ResourceWebhook{
 		Name:                        d.Get("name").(string),
 		Enabled:                     d.Get("enabled").(bool),
@@ -38,7 +40,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceWebhook, error) {
 	if err != nil {
 		log.Fatalf("Error serializing webhook to XML: %v", err)
 	}
-	log.Printf("[DEBUG] Constructed Jamf Pro Webhook XML:\n%s\n", xmlOutput)
+	logging.Debugf(ctx, logging.SubsystemCRUD, "Constructed Jamf Pro Webhook XML:\n%s", xmlOutput)
 
 	return resource, nil
 }